@@ -0,0 +1,48 @@
+package pokesdk
+
+import "context"
+
+const (
+	apiAbilityPath = "/ability"
+)
+
+type AbilityAPI struct {
+	ResourceAPI[Ability, AbilityRef]
+}
+
+func newAbilityAPI(cfg Config) AbilityAPI {
+	return AbilityAPI{newResourceAPI[Ability, AbilityRef](cfg, apiAbilityPath)}
+}
+
+// GetByPokemon retrieves every Ability the given Pokemon has, including those it had in past generations.
+// The returned map is keyed by ability name, and each ability is only fetched once even if it appears more than once.
+func (a AbilityAPI) GetByPokemon(ctx context.Context, pokemon *Pokemon) (map[string]*Ability, error) {
+	refs := make([]AbilityRef, 0, len(pokemon.Abilities))
+	for _, ability := range pokemon.Abilities {
+		refs = append(refs, ability.Ability)
+	}
+	for _, past := range pokemon.PastAbilities {
+		for _, ability := range past.Abilities {
+			refs = append(refs, ability.Ability)
+		}
+	}
+
+	abilities := make(map[string]*Ability, len(refs))
+	for _, ref := range refs {
+		// past abilities may have an empty ability if the slot didn't exist in that generation
+		if ref.URL == "" {
+			continue
+		}
+		if _, ok := abilities[ref.Name]; ok {
+			continue
+		}
+
+		ability, err := a.get(ctx, ref.URL)
+		if err != nil {
+			return nil, err
+		}
+		abilities[ref.Name] = ability
+	}
+
+	return abilities, nil
+}
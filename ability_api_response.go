@@ -0,0 +1,34 @@
+package pokesdk
+
+type AbilityRef = ResourceRef[Ability]
+
+type AbilityList = ResourceList[AbilityRef]
+
+type Ability struct {
+	ID                int                   `json:"id"`
+	Name              string                `json:"name"`
+	IsMainSeries      bool                  `json:"is_main_series"`
+	Generation        GenerationRef         `json:"generation"`
+	Names             LocalizedNames        `json:"names"`
+	EffectEntries     []VerboseEffect       `json:"effect_entries"`
+	EffectChanges     []AbilityEffectChange `json:"effect_changes"`
+	FlavorTextEntries []AbilityFlavorText   `json:"flavor_text_entries"`
+	Pokemon           []AbilityPokemon      `json:"pokemon"`
+}
+
+type AbilityEffectChange struct {
+	EffectEntries []Effect        `json:"effect_entries"`
+	VersionGroup  VersionGroupRef `json:"version_group"`
+}
+
+type AbilityFlavorText struct {
+	FlavorText   string          `json:"flavor_text"`
+	Language     LanguageRef     `json:"language"`
+	VersionGroup VersionGroupRef `json:"version_group"`
+}
+
+type AbilityPokemon struct {
+	IsHidden bool       `json:"is_hidden"`
+	Slot     int        `json:"slot"`
+	Pokemon  PokemonRef `json:"pokemon"`
+}
@@ -0,0 +1,76 @@
+package pokesdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jameshalsall/pokesdk/internal/backend"
+	"github.com/jameshalsall/pokesdk/pokesdktest"
+)
+
+func TestAbilityAPI_GetByPokemon(t *testing.T) {
+	ctx := context.Background()
+
+	pokemon := &Pokemon{
+		Abilities: []PokemonAbility{
+			{Ability: AbilityRef{Name: "overgrow", URL: "http://example.com/ability/65/"}, Slot: 1},
+			{Ability: AbilityRef{Name: "chlorophyll", URL: "http://example.com/ability/34/"}, Slot: 3, IsHidden: true},
+		},
+		PastAbilities: []PastAbilities{
+			{
+				Abilities: []PokemonAbility{
+					{Ability: AbilityRef{}, Slot: 3},
+					{Ability: AbilityRef{Name: "overgrow", URL: "http://example.com/ability/65/"}, Slot: 1},
+					{Ability: AbilityRef{Name: "leaf-guard", URL: "http://example.com/ability/102/"}, Slot: 2},
+				},
+			},
+		},
+	}
+
+	t.Run("it should get every current and past ability of a pokemon", func(t *testing.T) {
+		client, mocks := newAbilityApiForTests(t)
+		mocks.backend.HydrateWith([]byte(`{"id": 1, "name": "ability"}`))
+
+		mocks.backend.On("Process", ctx, "http://example.com/ability/65/", map[string]string(nil), mock.Anything).Return(nil).Once()
+		mocks.backend.On("Process", ctx, "http://example.com/ability/34/", map[string]string(nil), mock.Anything).Return(nil).Once()
+		mocks.backend.On("Process", ctx, "http://example.com/ability/102/", map[string]string(nil), mock.Anything).Return(nil).Once()
+
+		abilities, err := client.GetByPokemon(ctx, pokemon)
+
+		require.NoError(t, err)
+		assert.Len(t, abilities, 3)
+		assert.Contains(t, abilities, "overgrow")
+		assert.Contains(t, abilities, "chlorophyll")
+		assert.Contains(t, abilities, "leaf-guard")
+		mocks.backend.AssertExpectations(t)
+	})
+
+	t.Run("it should return an error if an ability does not exist", func(t *testing.T) {
+		client, mocks := newAbilityApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/ability/65/", map[string]string(nil), mock.Anything).Return(backend.ErrResourceNotFound).Once()
+
+		_, err := client.GetByPokemon(ctx, pokemon)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrAbilityNotFound)
+	})
+}
+
+type abilityApiMocks struct {
+	backend *pokesdktest.MockBackend
+}
+
+func newAbilityApiForTests(t *testing.T) (AbilityAPI, abilityApiMocks) {
+	t.Helper()
+
+	mocks := abilityApiMocks{
+		backend: &pokesdktest.MockBackend{},
+	}
+
+	return newAbilityAPI(Config{baseURL: "http://example.com/", backend: mocks.backend}), mocks
+}
@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiBerryPath = "/berry"
+)
+
+type BerryAPI struct {
+	ResourceAPI[Berry, BerryRef]
+}
+
+func newBerryAPI(cfg Config) BerryAPI {
+	return BerryAPI{newResourceAPI[Berry, BerryRef](cfg, apiBerryPath)}
+}
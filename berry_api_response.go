@@ -0,0 +1,25 @@
+package pokesdk
+
+type BerryRef = ResourceRef[Berry]
+
+type BerryList = ResourceList[BerryRef]
+
+type Berry struct {
+	ID               int              `json:"id"`
+	Name             string           `json:"name"`
+	GrowthTime       int              `json:"growth_time"`
+	MaxHarvest       int              `json:"max_harvest"`
+	NaturalGiftPower int              `json:"natural_gift_power"`
+	Size             int              `json:"size"`
+	Smoothness       int              `json:"smoothness"`
+	SoilDryness      int              `json:"soil_dryness"`
+	Firmness         BerryFirmnessRef `json:"firmness"`
+	Flavors          []BerryFlavorMap `json:"flavors"`
+	Item             ItemRef          `json:"item"`
+	NaturalGiftType  TypeRef          `json:"natural_gift_type"`
+}
+
+type BerryFlavorMap struct {
+	Potency int            `json:"potency"`
+	Flavor  BerryFlavorRef `json:"flavor"`
+}
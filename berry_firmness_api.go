@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiBerryFirmnessPath = "/berry-firmness"
+)
+
+type BerryFirmnessAPI struct {
+	ResourceAPI[BerryFirmness, BerryFirmnessRef]
+}
+
+func newBerryFirmnessAPI(cfg Config) BerryFirmnessAPI {
+	return BerryFirmnessAPI{newResourceAPI[BerryFirmness, BerryFirmnessRef](cfg, apiBerryFirmnessPath)}
+}
@@ -0,0 +1,12 @@
+package pokesdk
+
+type BerryFirmnessRef = ResourceRef[BerryFirmness]
+
+type BerryFirmnessList = ResourceList[BerryFirmnessRef]
+
+type BerryFirmness struct {
+	ID      int            `json:"id"`
+	Name    string         `json:"name"`
+	Berries []BerryRef     `json:"berries"`
+	Names   LocalizedNames `json:"names"`
+}
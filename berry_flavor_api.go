@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiBerryFlavorPath = "/berry-flavor"
+)
+
+type BerryFlavorAPI struct {
+	ResourceAPI[BerryFlavor, BerryFlavorRef]
+}
+
+func newBerryFlavorAPI(cfg Config) BerryFlavorAPI {
+	return BerryFlavorAPI{newResourceAPI[BerryFlavor, BerryFlavorRef](cfg, apiBerryFlavorPath)}
+}
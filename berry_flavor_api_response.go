@@ -0,0 +1,18 @@
+package pokesdk
+
+type BerryFlavorRef = ResourceRef[BerryFlavor]
+
+type BerryFlavorList = ResourceList[BerryFlavorRef]
+
+type BerryFlavor struct {
+	ID          int              `json:"id"`
+	Name        string           `json:"name"`
+	Berries     []FlavorBerryMap `json:"berries"`
+	ContestType ContestTypeRef   `json:"contest_type"`
+	Names       LocalizedNames   `json:"names"`
+}
+
+type FlavorBerryMap struct {
+	Potency int      `json:"potency"`
+	Berry   BerryRef `json:"berry"`
+}
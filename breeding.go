@@ -0,0 +1,66 @@
+package pokesdk
+
+const (
+	EggGroupDitto  = "ditto"
+	EggGroupNoEggs = "no-eggs"
+
+	// GenderRateGenderless is the PokemonSpecies.GenderRate of species that have no gender.
+	GenderRateGenderless = -1
+	// GenderRateAlwaysFemale is the PokemonSpecies.GenderRate of species that are always female, the rate being the
+	// chance of being female in eighths.
+	GenderRateAlwaysFemale = 8
+)
+
+// IsGenderless reports whether the species has no gender.
+func (s *PokemonSpecies) IsGenderless() bool {
+	return s.GenderRate == GenderRateGenderless
+}
+
+// CanBeMale reports whether members of the species can be male.
+func (s *PokemonSpecies) CanBeMale() bool {
+	return s.GenderRate >= 0 && s.GenderRate < GenderRateAlwaysFemale
+}
+
+// CanBeFemale reports whether members of the species can be female.
+func (s *PokemonSpecies) CanBeFemale() bool {
+	return s.GenderRate > 0
+}
+
+// CanBreed reports whether members of the two species can produce an egg together at the day care, based on their egg
+// groups and gender rates. Species in the no-eggs group never breed, and Ditto breeds with any other species except
+// another Ditto (it's the only partner genderless species can have). Otherwise the species must share an egg group and
+// one must be able to be male and the other female.
+func CanBreed(a, b *PokemonSpecies) bool {
+	if a.inEggGroup(EggGroupNoEggs) || b.inEggGroup(EggGroupNoEggs) {
+		return false
+	}
+
+	aDitto, bDitto := a.inEggGroup(EggGroupDitto), b.inEggGroup(EggGroupDitto)
+	if aDitto || bDitto {
+		return aDitto != bDitto
+	}
+
+	if !a.sharesEggGroup(b) {
+		return false
+	}
+
+	return (a.CanBeMale() && b.CanBeFemale()) || (a.CanBeFemale() && b.CanBeMale())
+}
+
+func (s *PokemonSpecies) inEggGroup(name string) bool {
+	for _, group := range s.EggGroups {
+		if group.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *PokemonSpecies) sharesEggGroup(other *PokemonSpecies) bool {
+	for _, group := range s.EggGroups {
+		if other.inEggGroup(group.Name) {
+			return true
+		}
+	}
+	return false
+}
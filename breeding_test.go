@@ -0,0 +1,78 @@
+package pokesdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newSpeciesForBreedingTests(name string, genderRate int, eggGroups ...string) *PokemonSpecies {
+	species := &PokemonSpecies{Name: name, GenderRate: genderRate}
+	for _, group := range eggGroups {
+		species.EggGroups = append(species.EggGroups, EggGroupRef{Name: group})
+	}
+	return species
+}
+
+func TestCanBreed(t *testing.T) {
+	bulbasaur := newSpeciesForBreedingTests("bulbasaur", 1, "monster", "plant")
+	charmander := newSpeciesForBreedingTests("charmander", 1, "monster", "dragon")
+	pikachu := newSpeciesForBreedingTests("pikachu", 4, "ground", "fairy")
+	nidorina := newSpeciesForBreedingTests("nidoran-f", 8, "monster", "ground")
+	chansey := newSpeciesForBreedingTests("chansey", 8, "fairy")
+	tauros := newSpeciesForBreedingTests("tauros", 0, "ground")
+	magnemite := newSpeciesForBreedingTests("magnemite", -1, "mineral")
+	voltorb := newSpeciesForBreedingTests("voltorb", -1, "mineral")
+	ditto := newSpeciesForBreedingTests("ditto", -1, "ditto")
+	mewtwo := newSpeciesForBreedingTests("mewtwo", -1, "no-eggs")
+	pichu := newSpeciesForBreedingTests("pichu", 4, "no-eggs")
+
+	tests := map[string]struct {
+		a, b *PokemonSpecies
+		want bool
+	}{
+		"species sharing an egg group":                 {a: bulbasaur, b: charmander, want: true},
+		"the same species":                             {a: pikachu, b: pikachu, want: true},
+		"species not sharing an egg group":             {a: bulbasaur, b: pikachu, want: false},
+		"an always female species with a male species": {a: chansey, b: pikachu, want: true},
+		"two always female species":                    {a: nidorina, b: nidorina, want: false},
+		"an always male and always female species":     {a: tauros, b: nidorina, want: true},
+		"two always male species":                      {a: tauros, b: tauros, want: false},
+		"two genderless species":                       {a: magnemite, b: voltorb, want: false},
+		"ditto with a genderless species":              {a: ditto, b: magnemite, want: true},
+		"ditto with a gendered species":                {a: pikachu, b: ditto, want: true},
+		"ditto with ditto":                             {a: ditto, b: ditto, want: false},
+		"ditto with a no-eggs species":                 {a: ditto, b: mewtwo, want: false},
+		"a baby species":                               {a: pichu, b: pikachu, want: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CanBreed(tt.a, tt.b))
+			assert.Equal(t, tt.want, CanBreed(tt.b, tt.a), "breeding should be symmetric")
+		})
+	}
+}
+
+func TestPokemonSpecies_Gender(t *testing.T) {
+	tests := map[string]struct {
+		rate                            int
+		genderless, canBeMale, canBeFem bool
+	}{
+		"genderless":     {rate: -1, genderless: true},
+		"always male":    {rate: 0, canBeMale: true},
+		"mixed":          {rate: 4, canBeMale: true, canBeFem: true},
+		"always female":  {rate: 8, canBeFem: true},
+		"mostly females": {rate: 7, canBeMale: true, canBeFem: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			species := &PokemonSpecies{GenderRate: tt.rate}
+
+			assert.Equal(t, tt.genderless, species.IsGenderless())
+			assert.Equal(t, tt.canBeMale, species.CanBeMale())
+			assert.Equal(t, tt.canBeFem, species.CanBeFemale())
+		})
+	}
+}
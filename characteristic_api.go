@@ -0,0 +1,50 @@
+package pokesdk
+
+import "context"
+
+const (
+	apiCharacteristicPath = "/characteristic"
+)
+
+type CharacteristicAPI struct {
+	resources ResourceAPI[Characteristic, CharacteristicRef]
+}
+
+func newCharacteristicAPI(cfg Config) CharacteristicAPI {
+	return CharacteristicAPI{resources: newResourceAPI[Characteristic, CharacteristicRef](cfg, apiCharacteristicPath)}
+}
+
+// ErrNotFound returns the error that's returned when getting a characteristic that doesn't exist, which is
+// ErrCharacteristicNotFound.
+func (c CharacteristicAPI) ErrNotFound() error {
+	return c.resources.ErrNotFound()
+}
+
+// List returns a Paginator for listing all Characteristics.
+// It accepts no context argument because it should be provided to the paginator's functions instead.
+func (c CharacteristicAPI) List() *ListPaginator[CharacteristicRef] {
+	return c.resources.List()
+}
+
+// GetByID retrieves a specific Characteristic by its ID.
+func (c CharacteristicAPI) GetByID(ctx context.Context, ID int, opts ...GetOption) (*Characteristic, error) {
+	return c.resources.GetByID(ctx, ID, opts...)
+}
+
+// GetByRef retrieves a specific Characteristic by its reference.
+// The reference is returned in the response from List()
+func (c CharacteristicAPI) GetByRef(ctx context.Context, ref CharacteristicRef, opts ...GetOption) (*Characteristic, error) {
+	return c.resources.GetByRef(ctx, ref, opts...)
+}
+
+// GetMany retrieves the characteristics with the given IDs in parallel. The results are in the same order as the IDs,
+// and a characteristic that can't be fetched has its error set in its Result without affecting the others.
+func (c CharacteristicAPI) GetMany(ctx context.Context, IDs []int, opts ...GetOption) []Result[Characteristic] {
+	return c.resources.getManyByID(ctx, IDs, opts)
+}
+
+// GetManyByRef retrieves the characteristics with the given references in parallel, such as the results of a page from
+// List(). It works the same way as GetMany.
+func (c CharacteristicAPI) GetManyByRef(ctx context.Context, refs []CharacteristicRef, opts ...GetOption) []Result[Characteristic] {
+	return c.resources.GetManyByRef(ctx, refs, opts...)
+}
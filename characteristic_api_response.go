@@ -0,0 +1,15 @@
+package pokesdk
+
+// CharacteristicRef references a Characteristic.
+// Characteristics are unnamed, so only the URL is populated.
+type CharacteristicRef = ResourceRef[Characteristic]
+
+type CharacteristicList = ResourceList[CharacteristicRef]
+
+type Characteristic struct {
+	ID             int           `json:"id"`
+	GeneModulo     int           `json:"gene_modulo"`
+	PossibleValues []int         `json:"possible_values"`
+	HighestStat    StatRef       `json:"highest_stat"`
+	Descriptions   []Description `json:"descriptions"`
+}
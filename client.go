@@ -1,16 +1,184 @@
 package pokesdk
 
 type Client struct {
+	cfg Config
+
 	// Pokemon provides access to the Pokemon API endpoints
 	Pokemon PokemonAPI
 	// Generation provides access to the Generation API endpoints
 	Generation GenerationAPI
+	// Species provides access to the Pokemon Species API endpoints
+	Species SpeciesAPI
+	// EvolutionChain provides access to the Evolution Chain API endpoints
+	EvolutionChain EvolutionChainAPI
+	// Ability provides access to the Ability API endpoints
+	Ability AbilityAPI
+	// Move provides access to the Move API endpoints
+	Move MoveAPI
+	// Type provides access to the Type API endpoints
+	Type TypeAPI
+	// Item provides access to the Item API endpoints
+	Item ItemAPI
+	// ItemCategory provides access to the Item Category API endpoints
+	ItemCategory ItemCategoryAPI
+	// ItemPocket provides access to the Item Pocket API endpoints
+	ItemPocket ItemPocketAPI
+	// ItemAttribute provides access to the Item Attribute API endpoints
+	ItemAttribute ItemAttributeAPI
+	// ItemFlingEffect provides access to the Item Fling Effect API endpoints
+	ItemFlingEffect ItemFlingEffectAPI
+	// Berry provides access to the Berry API endpoints
+	Berry BerryAPI
+	// BerryFirmness provides access to the Berry Firmness API endpoints
+	BerryFirmness BerryFirmnessAPI
+	// BerryFlavor provides access to the Berry Flavor API endpoints
+	BerryFlavor BerryFlavorAPI
+	// Region provides access to the Region API endpoints
+	Region RegionAPI
+	// Location provides access to the Location API endpoints
+	Location LocationAPI
+	// LocationArea provides access to the Location Area API endpoints
+	LocationArea LocationAreaAPI
+	// Version provides access to the Version API endpoints
+	Version VersionAPI
+	// VersionGroup provides access to the Version Group API endpoints
+	VersionGroup VersionGroupAPI
+	// Pokedex provides access to the Pokedex API endpoints
+	Pokedex PokedexAPI
+	// Nature provides access to the Nature API endpoints
+	Nature NatureAPI
+	// Stat provides access to the Stat API endpoints
+	Stat StatAPI
+	// Characteristic provides access to the Characteristic API endpoints
+	Characteristic CharacteristicAPI
+	// EggGroup provides access to the Egg Group API endpoints
+	EggGroup EggGroupAPI
+	// Gender provides access to the Gender API endpoints
+	Gender GenderAPI
+	// GrowthRate provides access to the Growth Rate API endpoints
+	GrowthRate GrowthRateAPI
+	// PokemonForm provides access to the Pokemon Form API endpoints
+	PokemonForm PokemonFormAPI
+	// Machine provides access to the Machine API endpoints
+	Machine MachineAPI
+	// ContestType provides access to the Contest Type API endpoints
+	ContestType ContestTypeAPI
+	// ContestEffect provides access to the Contest Effect API endpoints
+	ContestEffect ContestEffectAPI
+	// SuperContestEffect provides access to the Super Contest Effect API endpoints
+	SuperContestEffect SuperContestEffectAPI
+	// PokemonColor provides access to the Pokemon Color API endpoints
+	PokemonColor PokemonColorAPI
+	// PokemonShape provides access to the Pokemon Shape API endpoints
+	PokemonShape PokemonShapeAPI
+	// PokemonHabitat provides access to the Pokemon Habitat API endpoints
+	PokemonHabitat PokemonHabitatAPI
+	// EncounterMethod provides access to the Encounter Method API endpoints
+	EncounterMethod EncounterMethodAPI
+	// EncounterCondition provides access to the Encounter Condition API endpoints
+	EncounterCondition EncounterConditionAPI
+	// EncounterConditionValue provides access to the Encounter Condition Value API endpoints
+	EncounterConditionValue EncounterConditionValueAPI
+	// MoveAilment provides access to the Move Ailment API endpoints
+	MoveAilment MoveAilmentAPI
+	// MoveCategory provides access to the Move Category API endpoints
+	MoveCategory MoveCategoryAPI
+	// MoveDamageClass provides access to the Move Damage Class API endpoints
+	MoveDamageClass MoveDamageClassAPI
+	// MoveTarget provides access to the Move Target API endpoints
+	MoveTarget MoveTargetAPI
+	// MoveLearnMethod provides access to the Move Learn Method API endpoints
+	MoveLearnMethod MoveLearnMethodAPI
+	// MoveBattleStyle provides access to the Move Battle Style API endpoints
+	MoveBattleStyle MoveBattleStyleAPI
+	// Language provides access to the Language API endpoints
+	Language LanguageAPI
+	// PalParkArea provides access to the Pal Park Area API endpoints
+	PalParkArea PalParkAreaAPI
+	// PokeathlonStat provides access to the Pokeathlon Stat API endpoints
+	PokeathlonStat PokeathlonStatAPI
 }
 
 func NewClient(opts ...Option) *Client {
-	cfg := NewConfig(opts...)
+	return newClient(NewConfig(opts...))
+}
+
+func newClient(cfg Config) *Client {
 	return &Client{
-		Pokemon:    PokemonAPI{cfg: cfg},
-		Generation: GenerationAPI{cfg: cfg},
+		cfg: cfg,
+
+		Pokemon:                 newPokemonAPI(cfg),
+		Generation:              newGenerationAPI(cfg),
+		Species:                 newSpeciesAPI(cfg),
+		EvolutionChain:          newEvolutionChainAPI(cfg),
+		Ability:                 newAbilityAPI(cfg),
+		Move:                    newMoveAPI(cfg),
+		Type:                    newTypeAPI(cfg),
+		Item:                    newItemAPI(cfg),
+		ItemCategory:            newItemCategoryAPI(cfg),
+		ItemPocket:              newItemPocketAPI(cfg),
+		ItemAttribute:           newItemAttributeAPI(cfg),
+		ItemFlingEffect:         newItemFlingEffectAPI(cfg),
+		Berry:                   newBerryAPI(cfg),
+		BerryFirmness:           newBerryFirmnessAPI(cfg),
+		BerryFlavor:             newBerryFlavorAPI(cfg),
+		Region:                  newRegionAPI(cfg),
+		Location:                newLocationAPI(cfg),
+		LocationArea:            newLocationAreaAPI(cfg),
+		Version:                 newVersionAPI(cfg),
+		VersionGroup:            newVersionGroupAPI(cfg),
+		Pokedex:                 newPokedexAPI(cfg),
+		Nature:                  newNatureAPI(cfg),
+		Stat:                    newStatAPI(cfg),
+		Characteristic:          newCharacteristicAPI(cfg),
+		EggGroup:                newEggGroupAPI(cfg),
+		Gender:                  newGenderAPI(cfg),
+		GrowthRate:              newGrowthRateAPI(cfg),
+		PokemonForm:             newPokemonFormAPI(cfg),
+		Machine:                 newMachineAPI(cfg),
+		ContestType:             newContestTypeAPI(cfg),
+		ContestEffect:           newContestEffectAPI(cfg),
+		SuperContestEffect:      newSuperContestEffectAPI(cfg),
+		PokemonColor:            newPokemonColorAPI(cfg),
+		PokemonShape:            newPokemonShapeAPI(cfg),
+		PokemonHabitat:          newPokemonHabitatAPI(cfg),
+		EncounterMethod:         newEncounterMethodAPI(cfg),
+		EncounterCondition:      newEncounterConditionAPI(cfg),
+		EncounterConditionValue: newEncounterConditionValueAPI(cfg),
+		MoveAilment:             newMoveAilmentAPI(cfg),
+		MoveCategory:            newMoveCategoryAPI(cfg),
+		MoveDamageClass:         newMoveDamageClassAPI(cfg),
+		MoveTarget:              newMoveTargetAPI(cfg),
+		MoveLearnMethod:         newMoveLearnMethodAPI(cfg),
+		MoveBattleStyle:         newMoveBattleStyleAPI(cfg),
+		Language:                newLanguageAPI(cfg),
+		PalParkArea:             newPalParkAreaAPI(cfg),
+		PokeathlonStat:          newPokeathlonStatAPI(cfg),
+	}
+}
+
+// Languages returns the language fallback chain the client was configured with using WithLanguage.
+func (c *Client) Languages() []string {
+	return append([]string(nil), c.cfg.languages...)
+}
+
+// LocalizedName returns the name of the resource in the client's language, falling back through the languages it
+// was configured with.
+func (c *Client) LocalizedName(resource Localized) (string, bool) {
+	language, fallbacks := c.languageChain()
+	return resource.LocalizedName(language, fallbacks...)
+}
+
+// LocalizedFlavorText returns the flavor text of the resource in the client's language, falling back through the
+// languages it was configured with.
+func (c *Client) LocalizedFlavorText(resource LocalizedFlavor) (string, bool) {
+	language, fallbacks := c.languageChain()
+	return resource.LocalizedFlavorText(language, fallbacks...)
+}
+
+func (c *Client) languageChain() (string, []string) {
+	if len(c.cfg.languages) == 0 {
+		return DefaultLanguage, nil
 	}
+	return c.cfg.languages[0], c.cfg.languages[1:]
 }
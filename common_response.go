@@ -4,3 +4,69 @@ type NamedAPIResource struct {
 	Name string `json:"name"`
 	URL  string `json:"url"`
 }
+
+func (r NamedAPIResource) ref() NamedAPIResource {
+	return r
+}
+
+// ResourceList is a single page of references to resources, as returned when listing them.
+type ResourceList[R Reference] struct {
+	Count    int     `json:"count"`
+	Next     *string `json:"next"`
+	Previous *string `json:"previous"`
+	Results  []R     `json:"results"`
+}
+
+func (l *ResourceList[R]) GetNextURL() string {
+	if l.Next == nil {
+		return ""
+	}
+	return *l.Next
+}
+
+type Description struct {
+	Description string      `json:"description"`
+	Language    LanguageRef `json:"language"`
+}
+
+type FlavorText struct {
+	FlavorText string      `json:"flavor_text"`
+	Language   LanguageRef `json:"language"`
+	Version    *VersionRef `json:"version"`
+}
+
+type Effect struct {
+	Effect   string      `json:"effect"`
+	Language LanguageRef `json:"language"`
+}
+
+type VerboseEffect struct {
+	Effect      string      `json:"effect"`
+	ShortEffect string      `json:"short_effect"`
+	Language    LanguageRef `json:"language"`
+}
+
+type VersionGroupFlavorText struct {
+	Text         string          `json:"text"`
+	Language     LanguageRef     `json:"language"`
+	VersionGroup VersionGroupRef `json:"version_group"`
+}
+
+type GenerationGameIndex struct {
+	GameIndex  int           `json:"game_index"`
+	Generation GenerationRef `json:"generation"`
+}
+
+type VersionEncounterDetail struct {
+	Version          VersionRef  `json:"version"`
+	MaxChance        int         `json:"max_chance"`
+	EncounterDetails []Encounter `json:"encounter_details"`
+}
+
+type Encounter struct {
+	MinLevel        int                          `json:"min_level"`
+	MaxLevel        int                          `json:"max_level"`
+	ConditionValues []EncounterConditionValueRef `json:"condition_values"`
+	Chance          int                          `json:"chance"`
+	Method          EncounterMethodRef           `json:"method"`
+}
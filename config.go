@@ -20,8 +20,9 @@ type Backend interface {
 }
 
 type Config struct {
-	baseURL string
-	backend Backend
+	baseURL   string
+	backend   Backend
+	languages []string
 }
 
 // NewConfig creates a new Config instance with the provided options applied.
@@ -39,6 +40,10 @@ func NewConfig(opts ...Option) Config {
 		WithDefaultBaseURL()(&cfg)
 	}
 
+	if len(cfg.languages) == 0 {
+		WithLanguage(DefaultLanguage)(&cfg)
+	}
+
 	return cfg
 }
 
@@ -70,3 +75,13 @@ func WithDefaultBaseURL() Option {
 		cfg.baseURL = defaultBaseAPIURL
 	}
 }
+
+// WithLanguage sets the language used for localized text, along with the languages to fall back to (in order) when
+// text isn't available in it, e.g. WithLanguage("ja-Hrkt", "ja", "en"). It defaults to DefaultLanguage.
+// The language is applied by Client.LocalizedName and Client.LocalizedFlavorText; the LocalizedName methods of
+// resources take their languages explicitly.
+func WithLanguage(language string, fallbacks ...string) Option {
+	return func(cfg *Config) {
+		cfg.languages = append([]string{language}, fallbacks...)
+	}
+}
@@ -20,6 +20,7 @@ func TestNewConfig(t *testing.T) {
 		}
 
 		assert.Equal(t, defaultBaseAPIURL, cfg.baseURL)
+		assert.Equal(t, []string{DefaultLanguage}, cfg.languages)
 	})
 
 	t.Run("it can be configured with a custom base URL", func(t *testing.T) {
@@ -37,4 +38,10 @@ func TestNewConfig(t *testing.T) {
 		_, ok := cfg.backend.(*backend.HTTP)
 		assert.True(t, ok)
 	})
+
+	t.Run("it can be configured with a language and fallbacks", func(t *testing.T) {
+		cfg := NewConfig(WithLanguage("ja-Hrkt", "ja", "en"))
+
+		assert.Equal(t, []string{"ja-Hrkt", "ja", "en"}, cfg.languages)
+	})
 }
@@ -0,0 +1,50 @@
+package pokesdk
+
+import "context"
+
+const (
+	apiContestEffectPath = "/contest-effect"
+)
+
+type ContestEffectAPI struct {
+	resources ResourceAPI[ContestEffect, ContestEffectRef]
+}
+
+func newContestEffectAPI(cfg Config) ContestEffectAPI {
+	return ContestEffectAPI{resources: newResourceAPI[ContestEffect, ContestEffectRef](cfg, apiContestEffectPath)}
+}
+
+// ErrNotFound returns the error that's returned when getting a contest effect that doesn't exist, which is
+// ErrContestEffectNotFound.
+func (c ContestEffectAPI) ErrNotFound() error {
+	return c.resources.ErrNotFound()
+}
+
+// List returns a Paginator for listing all Contest Effects.
+// It accepts no context argument because it should be provided to the paginator's functions instead.
+func (c ContestEffectAPI) List() *ListPaginator[ContestEffectRef] {
+	return c.resources.List()
+}
+
+// GetByID retrieves a specific Contest Effect by its ID.
+func (c ContestEffectAPI) GetByID(ctx context.Context, ID int, opts ...GetOption) (*ContestEffect, error) {
+	return c.resources.GetByID(ctx, ID, opts...)
+}
+
+// GetByRef retrieves a specific Contest Effect by its reference.
+// The reference is returned in the response from List()
+func (c ContestEffectAPI) GetByRef(ctx context.Context, ref ContestEffectRef, opts ...GetOption) (*ContestEffect, error) {
+	return c.resources.GetByRef(ctx, ref, opts...)
+}
+
+// GetMany retrieves the contest effects with the given IDs in parallel. The results are in the same order as the IDs,
+// and a contest effect that can't be fetched has its error set in its Result without affecting the others.
+func (c ContestEffectAPI) GetMany(ctx context.Context, IDs []int, opts ...GetOption) []Result[ContestEffect] {
+	return c.resources.getManyByID(ctx, IDs, opts)
+}
+
+// GetManyByRef retrieves the contest effects with the given references in parallel, such as the results of a page from
+// List(). It works the same way as GetMany.
+func (c ContestEffectAPI) GetManyByRef(ctx context.Context, refs []ContestEffectRef, opts ...GetOption) []Result[ContestEffect] {
+	return c.resources.GetManyByRef(ctx, refs, opts...)
+}
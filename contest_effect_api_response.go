@@ -0,0 +1,15 @@
+package pokesdk
+
+// ContestEffectRef references a Contest Effect.
+// Contest effects are unnamed, so only the URL is populated.
+type ContestEffectRef = ResourceRef[ContestEffect]
+
+type ContestEffectList = ResourceList[ContestEffectRef]
+
+type ContestEffect struct {
+	ID                int          `json:"id"`
+	Appeal            int          `json:"appeal"`
+	Jam               int          `json:"jam"`
+	EffectEntries     []Effect     `json:"effect_entries"`
+	FlavorTextEntries []FlavorText `json:"flavor_text_entries"`
+}
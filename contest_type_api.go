@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiContestTypePath = "/contest-type"
+)
+
+type ContestTypeAPI struct {
+	ResourceAPI[ContestType, ContestTypeRef]
+}
+
+func newContestTypeAPI(cfg Config) ContestTypeAPI {
+	return ContestTypeAPI{newResourceAPI[ContestType, ContestTypeRef](cfg, apiContestTypePath)}
+}
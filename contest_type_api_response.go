@@ -0,0 +1,18 @@
+package pokesdk
+
+type ContestTypeRef = ResourceRef[ContestType]
+
+type ContestTypeList = ResourceList[ContestTypeRef]
+
+type ContestType struct {
+	ID          int            `json:"id"`
+	Name        string         `json:"name"`
+	BerryFlavor BerryFlavorRef `json:"berry_flavor"`
+	Names       []ContestName  `json:"names"`
+}
+
+type ContestName struct {
+	Name     string      `json:"name"`
+	Color    string      `json:"color"`
+	Language LanguageRef `json:"language"`
+}
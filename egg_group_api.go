@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiEggGroupPath = "/egg-group"
+)
+
+type EggGroupAPI struct {
+	ResourceAPI[EggGroup, EggGroupRef]
+}
+
+func newEggGroupAPI(cfg Config) EggGroupAPI {
+	return EggGroupAPI{newResourceAPI[EggGroup, EggGroupRef](cfg, apiEggGroupPath)}
+}
@@ -0,0 +1,12 @@
+package pokesdk
+
+type EggGroupRef = ResourceRef[EggGroup]
+
+type EggGroupList = ResourceList[EggGroupRef]
+
+type EggGroup struct {
+	ID             int            `json:"id"`
+	Name           string         `json:"name"`
+	Names          LocalizedNames `json:"names"`
+	PokemonSpecies []SpeciesRef   `json:"pokemon_species"`
+}
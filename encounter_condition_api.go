@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiEncounterConditionPath = "/encounter-condition"
+)
+
+type EncounterConditionAPI struct {
+	ResourceAPI[EncounterCondition, EncounterConditionRef]
+}
+
+func newEncounterConditionAPI(cfg Config) EncounterConditionAPI {
+	return EncounterConditionAPI{newResourceAPI[EncounterCondition, EncounterConditionRef](cfg, apiEncounterConditionPath)}
+}
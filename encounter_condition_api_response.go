@@ -0,0 +1,12 @@
+package pokesdk
+
+type EncounterConditionRef = ResourceRef[EncounterCondition]
+
+type EncounterConditionList = ResourceList[EncounterConditionRef]
+
+type EncounterCondition struct {
+	ID     int                          `json:"id"`
+	Name   string                       `json:"name"`
+	Names  LocalizedNames               `json:"names"`
+	Values []EncounterConditionValueRef `json:"values"`
+}
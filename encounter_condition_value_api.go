@@ -0,0 +1,31 @@
+package pokesdk
+
+import "context"
+
+const (
+	apiEncounterConditionValuePath = "/encounter-condition-value"
+)
+
+type EncounterConditionValueAPI struct {
+	ResourceAPI[EncounterConditionValue, EncounterConditionValueRef]
+}
+
+func newEncounterConditionValueAPI(cfg Config) EncounterConditionValueAPI {
+	return EncounterConditionValueAPI{newResourceAPI[EncounterConditionValue, EncounterConditionValueRef](cfg, apiEncounterConditionValuePath)}
+}
+
+// GetByEncounter retrieves the condition values of the given Encounter (e.g. time-morning or swarm-yes), grouped by
+// the name of the condition they belong to (e.g. time or swarm).
+// Encounters without conditions return an empty map.
+func (e EncounterConditionValueAPI) GetByEncounter(ctx context.Context, encounter Encounter) (map[string][]*EncounterConditionValue, error) {
+	grouped := make(map[string][]*EncounterConditionValue)
+	for _, ref := range encounter.ConditionValues {
+		value, err := e.get(ctx, ref.URL)
+		if err != nil {
+			return nil, err
+		}
+		grouped[value.Condition.Name] = append(grouped[value.Condition.Name], value)
+	}
+
+	return grouped, nil
+}
@@ -0,0 +1,12 @@
+package pokesdk
+
+type EncounterConditionValueRef = ResourceRef[EncounterConditionValue]
+
+type EncounterConditionValueList = ResourceList[EncounterConditionValueRef]
+
+type EncounterConditionValue struct {
+	ID        int                   `json:"id"`
+	Name      string                `json:"name"`
+	Condition EncounterConditionRef `json:"condition"`
+	Names     LocalizedNames        `json:"names"`
+}
@@ -0,0 +1,84 @@
+package pokesdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jameshalsall/pokesdk/internal/backend"
+	"github.com/jameshalsall/pokesdk/pokesdktest"
+)
+
+func TestEncounterConditionValueAPI_GetByEncounter(t *testing.T) {
+	ctx := context.Background()
+
+	encounter := Encounter{
+		ConditionValues: []EncounterConditionValueRef{
+			{Name: "time-morning", URL: "http://example.com/encounter-condition-value/3/"},
+			{Name: "time-day", URL: "http://example.com/encounter-condition-value/4/"},
+			{Name: "swarm-yes", URL: "http://example.com/encounter-condition-value/1/"},
+		},
+	}
+
+	t.Run("it should get the condition values of an encounter grouped by condition", func(t *testing.T) {
+		client, mocks := newEncounterConditionValueApiForTests(t)
+
+		for url, value := range map[string]EncounterConditionValue{
+			"http://example.com/encounter-condition-value/3/": {ID: 3, Name: "time-morning", Condition: EncounterConditionRef{Name: "time"}},
+			"http://example.com/encounter-condition-value/4/": {ID: 4, Name: "time-day", Condition: EncounterConditionRef{Name: "time"}},
+			"http://example.com/encounter-condition-value/1/": {ID: 1, Name: "swarm-yes", Condition: EncounterConditionRef{Name: "swarm"}},
+		} {
+			mocks.backend.On("Process", ctx, url, map[string]string(nil), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+				*args.Get(3).(*EncounterConditionValue) = value
+			}).Once()
+		}
+
+		grouped, err := client.GetByEncounter(ctx, encounter)
+
+		require.NoError(t, err)
+		require.Len(t, grouped, 2)
+		require.Len(t, grouped["time"], 2)
+		assert.Equal(t, "time-morning", grouped["time"][0].Name)
+		assert.Equal(t, "time-day", grouped["time"][1].Name)
+		require.Len(t, grouped["swarm"], 1)
+		assert.Equal(t, "swarm-yes", grouped["swarm"][0].Name)
+		mocks.backend.AssertExpectations(t)
+	})
+
+	t.Run("it should return an empty map for an encounter without conditions", func(t *testing.T) {
+		client, _ := newEncounterConditionValueApiForTests(t)
+
+		grouped, err := client.GetByEncounter(ctx, Encounter{})
+
+		require.NoError(t, err)
+		assert.Empty(t, grouped)
+	})
+
+	t.Run("it should return an error if a condition value does not exist", func(t *testing.T) {
+		client, mocks := newEncounterConditionValueApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/encounter-condition-value/3/", map[string]string(nil), mock.Anything).Return(backend.ErrResourceNotFound).Once()
+
+		_, err := client.GetByEncounter(ctx, encounter)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrEncounterConditionValueNotFound)
+	})
+}
+
+type encounterConditionValueApiMocks struct {
+	backend *pokesdktest.MockBackend
+}
+
+func newEncounterConditionValueApiForTests(t *testing.T) (EncounterConditionValueAPI, encounterConditionValueApiMocks) {
+	t.Helper()
+
+	mocks := encounterConditionValueApiMocks{
+		backend: &pokesdktest.MockBackend{},
+	}
+
+	return newEncounterConditionValueAPI(Config{baseURL: "http://example.com/", backend: mocks.backend}), mocks
+}
@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiEncounterMethodPath = "/encounter-method"
+)
+
+type EncounterMethodAPI struct {
+	ResourceAPI[EncounterMethod, EncounterMethodRef]
+}
+
+func newEncounterMethodAPI(cfg Config) EncounterMethodAPI {
+	return EncounterMethodAPI{newResourceAPI[EncounterMethod, EncounterMethodRef](cfg, apiEncounterMethodPath)}
+}
@@ -0,0 +1,12 @@
+package pokesdk
+
+type EncounterMethodRef = ResourceRef[EncounterMethod]
+
+type EncounterMethodList = ResourceList[EncounterMethodRef]
+
+type EncounterMethod struct {
+	ID    int            `json:"id"`
+	Name  string         `json:"name"`
+	Order int            `json:"order"`
+	Names LocalizedNames `json:"names"`
+}
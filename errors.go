@@ -6,7 +6,53 @@ import (
 )
 
 var (
-	errNotFound           = errors.New("not found")
-	ErrPokemonNotFound    = fmt.Errorf("pokemon: %w", errNotFound)
-	ErrGenerationNotFound = fmt.Errorf("generation: %w", errNotFound)
+	// ErrNotFound is wrapped by the not-found error of every resource.
+	ErrNotFound                        = errors.New("not found")
+	ErrPokemonNotFound                 = fmt.Errorf("pokemon: %w", ErrNotFound)
+	ErrGenerationNotFound              = fmt.Errorf("generation: %w", ErrNotFound)
+	ErrSpeciesNotFound                 = fmt.Errorf("pokemon species: %w", ErrNotFound)
+	ErrEvolutionChainNotFound          = fmt.Errorf("evolution chain: %w", ErrNotFound)
+	ErrAbilityNotFound                 = fmt.Errorf("ability: %w", ErrNotFound)
+	ErrMoveNotFound                    = fmt.Errorf("move: %w", ErrNotFound)
+	ErrTypeNotFound                    = fmt.Errorf("type: %w", ErrNotFound)
+	ErrItemNotFound                    = fmt.Errorf("item: %w", ErrNotFound)
+	ErrItemCategoryNotFound            = fmt.Errorf("item category: %w", ErrNotFound)
+	ErrItemPocketNotFound              = fmt.Errorf("item pocket: %w", ErrNotFound)
+	ErrItemAttributeNotFound           = fmt.Errorf("item attribute: %w", ErrNotFound)
+	ErrItemFlingEffectNotFound         = fmt.Errorf("item fling effect: %w", ErrNotFound)
+	ErrBerryNotFound                   = fmt.Errorf("berry: %w", ErrNotFound)
+	ErrBerryFirmnessNotFound           = fmt.Errorf("berry firmness: %w", ErrNotFound)
+	ErrBerryFlavorNotFound             = fmt.Errorf("berry flavor: %w", ErrNotFound)
+	ErrRegionNotFound                  = fmt.Errorf("region: %w", ErrNotFound)
+	ErrLocationNotFound                = fmt.Errorf("location: %w", ErrNotFound)
+	ErrLocationAreaNotFound            = fmt.Errorf("location area: %w", ErrNotFound)
+	ErrVersionNotFound                 = fmt.Errorf("version: %w", ErrNotFound)
+	ErrVersionGroupNotFound            = fmt.Errorf("version group: %w", ErrNotFound)
+	ErrPokedexNotFound                 = fmt.Errorf("pokedex: %w", ErrNotFound)
+	ErrNatureNotFound                  = fmt.Errorf("nature: %w", ErrNotFound)
+	ErrStatNotFound                    = fmt.Errorf("stat: %w", ErrNotFound)
+	ErrCharacteristicNotFound          = fmt.Errorf("characteristic: %w", ErrNotFound)
+	ErrEggGroupNotFound                = fmt.Errorf("egg group: %w", ErrNotFound)
+	ErrGenderNotFound                  = fmt.Errorf("gender: %w", ErrNotFound)
+	ErrGrowthRateNotFound              = fmt.Errorf("growth rate: %w", ErrNotFound)
+	ErrPokemonFormNotFound             = fmt.Errorf("pokemon form: %w", ErrNotFound)
+	ErrMachineNotFound                 = fmt.Errorf("machine: %w", ErrNotFound)
+	ErrContestTypeNotFound             = fmt.Errorf("contest type: %w", ErrNotFound)
+	ErrContestEffectNotFound           = fmt.Errorf("contest effect: %w", ErrNotFound)
+	ErrSuperContestEffectNotFound      = fmt.Errorf("super contest effect: %w", ErrNotFound)
+	ErrPokemonColorNotFound            = fmt.Errorf("pokemon color: %w", ErrNotFound)
+	ErrPokemonShapeNotFound            = fmt.Errorf("pokemon shape: %w", ErrNotFound)
+	ErrPokemonHabitatNotFound          = fmt.Errorf("pokemon habitat: %w", ErrNotFound)
+	ErrEncounterMethodNotFound         = fmt.Errorf("encounter method: %w", ErrNotFound)
+	ErrEncounterConditionNotFound      = fmt.Errorf("encounter condition: %w", ErrNotFound)
+	ErrEncounterConditionValueNotFound = fmt.Errorf("encounter condition value: %w", ErrNotFound)
+	ErrMoveAilmentNotFound             = fmt.Errorf("move ailment: %w", ErrNotFound)
+	ErrMoveCategoryNotFound            = fmt.Errorf("move category: %w", ErrNotFound)
+	ErrMoveDamageClassNotFound         = fmt.Errorf("move damage class: %w", ErrNotFound)
+	ErrMoveTargetNotFound              = fmt.Errorf("move target: %w", ErrNotFound)
+	ErrMoveLearnMethodNotFound         = fmt.Errorf("move learn method: %w", ErrNotFound)
+	ErrMoveBattleStyleNotFound         = fmt.Errorf("move battle style: %w", ErrNotFound)
+	ErrLanguageNotFound                = fmt.Errorf("language: %w", ErrNotFound)
+	ErrPalParkAreaNotFound             = fmt.Errorf("pal park area: %w", ErrNotFound)
+	ErrPokeathlonStatNotFound          = fmt.Errorf("pokeathlon stat: %w", ErrNotFound)
 )
@@ -0,0 +1,142 @@
+package pokesdk
+
+import "slices"
+
+const (
+	EvolutionTriggerLevelUp = "level-up"
+	EvolutionTriggerTrade   = "trade"
+	EvolutionTriggerUseItem = "use-item"
+	EvolutionTriggerShed    = "shed"
+)
+
+// EvolutionPath is a single route through an evolution chain, from the base species to a fully evolved species.
+// Steps[i] describes how Species[i] evolves into Species[i+1], so a species that does not evolve has no steps.
+type EvolutionPath struct {
+	Species []SpeciesRef
+	Steps   []EvolutionStep
+}
+
+// EvolutionStep describes a single evolution between two species, along with the conditions that trigger it.
+// A step can have multiple details when there is more than one way to trigger the evolution.
+type EvolutionStep struct {
+	From    SpeciesRef
+	To      SpeciesRef
+	Details []EvolutionDetail
+}
+
+// Flatten returns every link in the chain in depth-first order, starting with the base species.
+func (c *EvolutionChain) Flatten() []*ChainLink {
+	return c.Chain.Flatten()
+}
+
+// Find returns the link for the named species, or nil if the species is not part of the chain.
+func (c *EvolutionChain) Find(species string) *ChainLink {
+	for _, link := range c.Flatten() {
+		if link.Species.Name == species {
+			return link
+		}
+	}
+	return nil
+}
+
+// Predecessors returns the species that evolve into the named species, ordered from the base species of the chain.
+// It returns nil if the species is the base of the chain or is not part of it.
+func (c *EvolutionChain) Predecessors(species string) []SpeciesRef {
+	path, ok := c.Chain.pathTo(species)
+	if !ok {
+		return nil
+	}
+
+	var predecessors []SpeciesRef
+	for _, link := range path[:len(path)-1] {
+		predecessors = append(predecessors, link.Species)
+	}
+	return predecessors
+}
+
+// Successors returns every species that the named species can eventually evolve into, in depth-first order.
+// It returns nil if the species is fully evolved or is not part of the chain.
+func (c *EvolutionChain) Successors(species string) []SpeciesRef {
+	link := c.Find(species)
+	if link == nil {
+		return nil
+	}
+
+	var successors []SpeciesRef
+	for _, l := range link.Flatten()[1:] {
+		successors = append(successors, l.Species)
+	}
+	return successors
+}
+
+// Paths returns every evolution path in the chain, from the base species to each fully evolved species.
+func (c *EvolutionChain) Paths() []EvolutionPath {
+	var paths []EvolutionPath
+
+	var walk func(link *ChainLink, species []SpeciesRef, steps []EvolutionStep)
+	walk = func(link *ChainLink, species []SpeciesRef, steps []EvolutionStep) {
+		// clip the slices so that sibling branches never share a backing array
+		species = append(slices.Clip(species), link.Species)
+		if len(link.EvolvesTo) == 0 {
+			paths = append(paths, EvolutionPath{Species: species, Steps: steps})
+			return
+		}
+
+		for i := range link.EvolvesTo {
+			next := &link.EvolvesTo[i]
+			step := EvolutionStep{From: link.Species, To: next.Species, Details: next.EvolutionDetails}
+			walk(next, species, append(slices.Clip(steps), step))
+		}
+	}
+	walk(&c.Chain, nil, nil)
+
+	return paths
+}
+
+// Flatten returns this link and every link it can evolve into, in depth-first order.
+func (l *ChainLink) Flatten() []*ChainLink {
+	links := []*ChainLink{l}
+	for i := range l.EvolvesTo {
+		links = append(links, l.EvolvesTo[i].Flatten()...)
+	}
+	return links
+}
+
+// pathTo returns the links from this link down to the named species (inclusive).
+func (l *ChainLink) pathTo(species string) ([]*ChainLink, bool) {
+	if l.Species.Name == species {
+		return []*ChainLink{l}, true
+	}
+
+	for i := range l.EvolvesTo {
+		if path, ok := l.EvolvesTo[i].pathTo(species); ok {
+			return append([]*ChainLink{l}, path...), true
+		}
+	}
+	return nil, false
+}
+
+// IsLevelUp reports whether the evolution is triggered by levelling up.
+func (d EvolutionDetail) IsLevelUp() bool {
+	return d.Trigger.Name == EvolutionTriggerLevelUp
+}
+
+// IsTrade reports whether the evolution is triggered by trading.
+func (d EvolutionDetail) IsTrade() bool {
+	return d.Trigger.Name == EvolutionTriggerTrade
+}
+
+// IsItemUse reports whether the evolution is triggered by using an item.
+func (d EvolutionDetail) IsItemUse() bool {
+	return d.Trigger.Name == EvolutionTriggerUseItem
+}
+
+// RequiresFriendship reports whether the evolution requires a minimum happiness (friendship) level.
+func (d EvolutionDetail) RequiresFriendship() bool {
+	return d.MinHappiness != nil
+}
+
+// RequiresTimeOfDay reports whether the evolution can only happen at a specific time of day.
+func (d EvolutionDetail) RequiresTimeOfDay() bool {
+	return d.TimeOfDay != ""
+}
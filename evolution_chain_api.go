@@ -0,0 +1,55 @@
+package pokesdk
+
+import "context"
+
+const (
+	apiEvolutionChainPath = "/evolution-chain"
+)
+
+type EvolutionChainAPI struct {
+	resources ResourceAPI[EvolutionChain, EvolutionChainRef]
+}
+
+func newEvolutionChainAPI(cfg Config) EvolutionChainAPI {
+	return EvolutionChainAPI{resources: newResourceAPI[EvolutionChain, EvolutionChainRef](cfg, apiEvolutionChainPath)}
+}
+
+// ErrNotFound returns the error that's returned when getting an evolution chain that doesn't exist, which is
+// ErrEvolutionChainNotFound.
+func (e EvolutionChainAPI) ErrNotFound() error {
+	return e.resources.ErrNotFound()
+}
+
+// List returns a Paginator for listing all Evolution Chains.
+// It accepts no context argument because it should be provided to the paginator's functions instead.
+func (e EvolutionChainAPI) List() *ListPaginator[EvolutionChainRef] {
+	return e.resources.List()
+}
+
+// GetByID retrieves a specific Evolution Chain by its ID.
+func (e EvolutionChainAPI) GetByID(ctx context.Context, ID int, opts ...GetOption) (*EvolutionChain, error) {
+	return e.resources.GetByID(ctx, ID, opts...)
+}
+
+// GetByRef retrieves a specific Evolution Chain by its reference.
+// The reference is returned in the response from List()
+func (e EvolutionChainAPI) GetByRef(ctx context.Context, ref EvolutionChainRef, opts ...GetOption) (*EvolutionChain, error) {
+	return e.resources.GetByRef(ctx, ref, opts...)
+}
+
+// GetMany retrieves the evolution chains with the given IDs in parallel. The results are in the same order as the IDs,
+// and an evolution chain that can't be fetched has its error set in its Result without affecting the others.
+func (e EvolutionChainAPI) GetMany(ctx context.Context, IDs []int, opts ...GetOption) []Result[EvolutionChain] {
+	return e.resources.getManyByID(ctx, IDs, opts)
+}
+
+// GetManyByRef retrieves the evolution chains with the given references in parallel, such as the results of a page from
+// List(). It works the same way as GetMany.
+func (e EvolutionChainAPI) GetManyByRef(ctx context.Context, refs []EvolutionChainRef, opts ...GetOption) []Result[EvolutionChain] {
+	return e.resources.GetManyByRef(ctx, refs, opts...)
+}
+
+// GetBySpecies retrieves the Evolution Chain that the given Pokemon Species is part of.
+func (e EvolutionChainAPI) GetBySpecies(ctx context.Context, species *PokemonSpecies) (*EvolutionChain, error) {
+	return e.resources.get(ctx, species.EvolutionChain.URL)
+}
@@ -0,0 +1,43 @@
+package pokesdk
+
+// EvolutionChainRef references an Evolution Chain.
+// Evolution chains are unnamed, so only the URL is populated.
+type EvolutionChainRef = ResourceRef[EvolutionChain]
+
+type EvolutionChainList = ResourceList[EvolutionChainRef]
+
+type EvolutionChain struct {
+	ID              int       `json:"id"`
+	BabyTriggerItem *ItemRef  `json:"baby_trigger_item"`
+	Chain           ChainLink `json:"chain"`
+}
+
+// ChainLink is a single node in an evolution chain. Each link holds the species at that stage, the conditions
+// required to evolve into it from the previous link and the links it can evolve into next.
+type ChainLink struct {
+	IsBaby           bool              `json:"is_baby"`
+	Species          SpeciesRef        `json:"species"`
+	EvolutionDetails []EvolutionDetail `json:"evolution_details"`
+	EvolvesTo        []ChainLink       `json:"evolves_to"`
+}
+
+type EvolutionDetail struct {
+	Item                  *ItemRef         `json:"item"`
+	Trigger               NamedAPIResource `json:"trigger"`
+	Gender                *int             `json:"gender"`
+	HeldItem              *ItemRef         `json:"held_item"`
+	KnownMove             *MoveRef         `json:"known_move"`
+	KnownMoveType         *TypeRef         `json:"known_move_type"`
+	Location              *LocationRef     `json:"location"`
+	MinLevel              *int             `json:"min_level"`
+	MinHappiness          *int             `json:"min_happiness"`
+	MinBeauty             *int             `json:"min_beauty"`
+	MinAffection          *int             `json:"min_affection"`
+	NeedsOverworldRain    bool             `json:"needs_overworld_rain"`
+	PartySpecies          *SpeciesRef      `json:"party_species"`
+	PartyType             *TypeRef         `json:"party_type"`
+	RelativePhysicalStats *int             `json:"relative_physical_stats"`
+	TimeOfDay             string           `json:"time_of_day"`
+	TradeSpecies          *SpeciesRef      `json:"trade_species"`
+	TurnUpsideDown        bool             `json:"turn_upside_down"`
+}
@@ -0,0 +1,59 @@
+package pokesdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jameshalsall/pokesdk/internal/backend"
+	"github.com/jameshalsall/pokesdk/pokesdktest"
+)
+
+func TestEvolutionChainAPI_GetBySpecies(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("it should get the evolution chain of a species", func(t *testing.T) {
+		client, mocks := newEvolutionChainApiForTests(t)
+		mocks.backend.HydrateWith([]byte(`{"id": 1, "chain": {"species": {"name": "bulbasaur"}, "evolves_to": [{"species": {"name": "ivysaur"}}]}}`))
+
+		mocks.backend.On("Process", ctx, "http://example.com/evolution-chain/1/", map[string]string(nil), mock.Anything).Return(nil).Once()
+
+		species := &PokemonSpecies{EvolutionChain: EvolutionChainRef{URL: "http://example.com/evolution-chain/1/"}}
+		chain, err := client.GetBySpecies(ctx, species)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, chain.ID)
+		assert.Equal(t, "bulbasaur", chain.Chain.Species.Name)
+		require.Len(t, chain.Chain.EvolvesTo, 1)
+		assert.Equal(t, "ivysaur", chain.Chain.EvolvesTo[0].Species.Name)
+	})
+
+	t.Run("it should return an error if the evolution chain does not exist", func(t *testing.T) {
+		client, mocks := newEvolutionChainApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/evolution-chain/99/", map[string]string(nil), mock.Anything).Return(backend.ErrResourceNotFound).Once()
+
+		species := &PokemonSpecies{EvolutionChain: EvolutionChainRef{URL: "http://example.com/evolution-chain/99/"}}
+		_, err := client.GetBySpecies(ctx, species)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrEvolutionChainNotFound)
+	})
+}
+
+type evolutionChainApiMocks struct {
+	backend *pokesdktest.MockBackend
+}
+
+func newEvolutionChainApiForTests(t *testing.T) (EvolutionChainAPI, evolutionChainApiMocks) {
+	t.Helper()
+
+	mocks := evolutionChainApiMocks{
+		backend: &pokesdktest.MockBackend{},
+	}
+
+	return newEvolutionChainAPI(Config{baseURL: "http://example.com/", backend: mocks.backend}), mocks
+}
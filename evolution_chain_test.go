@@ -0,0 +1,158 @@
+package pokesdk
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// a trimmed down version of the oddish chain, which branches at its second stage
+const oddishChainJSON = `{
+	"id": 18,
+	"chain": {
+		"species": {"name": "oddish", "url": "https://pokeapi.co/api/v2/pokemon-species/43/"},
+		"evolution_details": [],
+		"evolves_to": [{
+			"species": {"name": "gloom", "url": "https://pokeapi.co/api/v2/pokemon-species/44/"},
+			"evolution_details": [{"min_level": 21, "trigger": {"name": "level-up", "url": ""}, "time_of_day": ""}],
+			"evolves_to": [
+				{
+					"species": {"name": "vileplume", "url": "https://pokeapi.co/api/v2/pokemon-species/45/"},
+					"evolution_details": [{"item": {"name": "leaf-stone", "url": ""}, "trigger": {"name": "use-item", "url": ""}}],
+					"evolves_to": []
+				},
+				{
+					"species": {"name": "bellossom", "url": "https://pokeapi.co/api/v2/pokemon-species/182/"},
+					"evolution_details": [{"item": {"name": "sun-stone", "url": ""}, "trigger": {"name": "use-item", "url": ""}}],
+					"evolves_to": []
+				}
+			]
+		}]
+	}
+}`
+
+func newOddishChainForTests(t *testing.T) *EvolutionChain {
+	t.Helper()
+
+	chain := &EvolutionChain{}
+	require.NoError(t, json.Unmarshal([]byte(oddishChainJSON), chain))
+	return chain
+}
+
+func speciesNames(refs []SpeciesRef) []string {
+	var names []string
+	for _, ref := range refs {
+		names = append(names, ref.Name)
+	}
+	return names
+}
+
+func TestEvolutionChain_Flatten(t *testing.T) {
+	t.Run("it returns every link in depth-first order", func(t *testing.T) {
+		chain := newOddishChainForTests(t)
+
+		var names []string
+		for _, link := range chain.Flatten() {
+			names = append(names, link.Species.Name)
+		}
+
+		assert.Equal(t, []string{"oddish", "gloom", "vileplume", "bellossom"}, names)
+	})
+}
+
+func TestEvolutionChain_Find(t *testing.T) {
+	chain := newOddishChainForTests(t)
+
+	t.Run("it finds a link by species name", func(t *testing.T) {
+		link := chain.Find("gloom")
+
+		require.NotNil(t, link)
+		assert.Equal(t, "gloom", link.Species.Name)
+		assert.Len(t, link.EvolvesTo, 2)
+	})
+
+	t.Run("it returns nil if the species is not in the chain", func(t *testing.T) {
+		assert.Nil(t, chain.Find("pikachu"))
+	})
+}
+
+func TestEvolutionChain_Predecessors(t *testing.T) {
+	chain := newOddishChainForTests(t)
+
+	t.Run("it returns the predecessors ordered from the base species", func(t *testing.T) {
+		assert.Equal(t, []string{"oddish", "gloom"}, speciesNames(chain.Predecessors("bellossom")))
+	})
+
+	t.Run("it returns nil for the base species", func(t *testing.T) {
+		assert.Nil(t, chain.Predecessors("oddish"))
+	})
+
+	t.Run("it returns nil if the species is not in the chain", func(t *testing.T) {
+		assert.Nil(t, chain.Predecessors("pikachu"))
+	})
+}
+
+func TestEvolutionChain_Successors(t *testing.T) {
+	chain := newOddishChainForTests(t)
+
+	t.Run("it returns every species the species can evolve into", func(t *testing.T) {
+		assert.Equal(t, []string{"gloom", "vileplume", "bellossom"}, speciesNames(chain.Successors("oddish")))
+		assert.Equal(t, []string{"vileplume", "bellossom"}, speciesNames(chain.Successors("gloom")))
+	})
+
+	t.Run("it returns nil for a fully evolved species", func(t *testing.T) {
+		assert.Nil(t, chain.Successors("vileplume"))
+	})
+}
+
+func TestEvolutionChain_Paths(t *testing.T) {
+	t.Run("it returns a path for each fully evolved species", func(t *testing.T) {
+		chain := newOddishChainForTests(t)
+
+		paths := chain.Paths()
+
+		require.Len(t, paths, 2)
+		assert.Equal(t, []string{"oddish", "gloom", "vileplume"}, speciesNames(paths[0].Species))
+		assert.Equal(t, []string{"oddish", "gloom", "bellossom"}, speciesNames(paths[1].Species))
+
+		require.Len(t, paths[0].Steps, 2)
+		assert.Equal(t, "oddish", paths[0].Steps[0].From.Name)
+		assert.Equal(t, "gloom", paths[0].Steps[0].To.Name)
+		require.Len(t, paths[0].Steps[0].Details, 1)
+		assert.True(t, paths[0].Steps[0].Details[0].IsLevelUp())
+		assert.Equal(t, 21, *paths[0].Steps[0].Details[0].MinLevel)
+
+		require.Len(t, paths[1].Steps, 2)
+		assert.True(t, paths[1].Steps[1].Details[0].IsItemUse())
+		assert.Equal(t, "sun-stone", paths[1].Steps[1].Details[0].Item.Name)
+		assert.Equal(t, "leaf-stone", paths[0].Steps[1].Details[0].Item.Name)
+	})
+
+	t.Run("it returns a single path with no steps for a species that does not evolve", func(t *testing.T) {
+		chain := &EvolutionChain{Chain: ChainLink{Species: SpeciesRef{Name: "tauros"}}}
+
+		paths := chain.Paths()
+
+		require.Len(t, paths, 1)
+		assert.Equal(t, []string{"tauros"}, speciesNames(paths[0].Species))
+		assert.Empty(t, paths[0].Steps)
+	})
+}
+
+func TestEvolutionDetail_Conditions(t *testing.T) {
+	happiness := 220
+	detail := EvolutionDetail{Trigger: NamedAPIResource{Name: EvolutionTriggerLevelUp}, MinHappiness: &happiness, TimeOfDay: "day"}
+
+	assert.True(t, detail.IsLevelUp())
+	assert.False(t, detail.IsTrade())
+	assert.False(t, detail.IsItemUse())
+	assert.True(t, detail.RequiresFriendship())
+	assert.True(t, detail.RequiresTimeOfDay())
+
+	trade := EvolutionDetail{Trigger: NamedAPIResource{Name: EvolutionTriggerTrade}}
+	assert.True(t, trade.IsTrade())
+	assert.False(t, trade.RequiresFriendship())
+	assert.False(t, trade.RequiresTimeOfDay())
+}
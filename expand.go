@@ -0,0 +1,309 @@
+package pokesdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/jameshalsall/pokesdk/internal/urlutil"
+)
+
+const (
+	// MaxExpandDepth is the maximum number of fields in a path given to Expand.
+	MaxExpandDepth = 4
+
+	// maxExpandConcurrency is the maximum number of resources fetched at once while expanding.
+	maxExpandConcurrency = 10
+)
+
+// ErrInvalidExpand is returned when a path given to Expand doesn't match the fields of the resource.
+var ErrInvalidExpand = errors.New("pokesdk: invalid expand path")
+
+// GetOption configures a call to one of the getters of an API, such as GetByName.
+type GetOption func(opts *getOptions)
+
+type getOptions struct {
+	expand      []string
+	concurrency int
+}
+
+func newGetOptions(opts []GetOption) getOptions {
+	o := getOptions{concurrency: defaultGetManyConcurrency}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// Expand fetches the resources referenced by the given paths along with the resource being retrieved, and attaches
+// them to its references so they can be read using ResourceRef.Expanded.
+// A path is made up of JSON field names separated by dots, e.g. "species.evolution_chain" fetches the species of a
+// Pokemon and then the evolution chain of the species. A path that ends on an object or a list of them only expands the
+// references directly inside it, so "types" fetches each of a Pokemon's types.
+// References are fetched concurrently, each resource is only fetched once, and paths can be at most MaxExpandDepth
+// fields deep.
+func Expand(paths ...string) GetOption {
+	return func(opts *getOptions) {
+		opts.expand = append(opts.expand, paths...)
+	}
+}
+
+// planExpand checks the paths given to Expand in the options against the type of the resource, so that invalid paths
+// are reported before the resource is fetched. It returns nil if there is nothing to expand.
+func planExpand(typ reflect.Type, opts []GetOption) (*expandNode, error) {
+	o := newGetOptions(opts)
+	if len(o.expand) == 0 {
+		return nil, nil
+	}
+	return buildExpandTree(typ, o.expand)
+}
+
+// expandable is implemented by pointers to typed references, which can hold the resource they refer to.
+type expandable interface {
+	Reference
+	resourceType() reflect.Type
+	expand(resource any)
+}
+
+// expandNode is a tree of the fields to expand, built from the paths given to Expand.
+type expandNode struct {
+	children map[string]*expandNode
+}
+
+// expandTarget identifies a resource that has been fetched and walked with an expand node, so that a resource
+// referenced from more than one place is only walked once.
+type expandTarget struct {
+	url  string
+	node *expandNode
+}
+
+type expandFetch struct {
+	done     chan struct{}
+	resource reflect.Value
+	err      error
+}
+
+type expander struct {
+	cfg    Config
+	cancel context.CancelFunc
+	sem    chan struct{}
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	fetches map[string]*expandFetch
+	walked  map[expandTarget]bool
+	err     error
+}
+
+// expand fetches the references of the resource (a pointer to a struct) selected by the tree from planExpand.
+func expand(ctx context.Context, cfg Config, resource any, tree *expandNode) error {
+	if tree == nil {
+		return nil
+	}
+
+	root := reflect.ValueOf(resource).Elem()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	e := &expander{
+		cfg:     cfg,
+		cancel:  cancel,
+		sem:     make(chan struct{}, maxExpandConcurrency),
+		fetches: make(map[string]*expandFetch),
+		walked:  make(map[expandTarget]bool),
+	}
+	e.walk(ctx, root, tree)
+	e.wg.Wait()
+
+	return e.err
+}
+
+// buildExpandTree checks the paths against the fields of the given type and merges them into a tree.
+func buildExpandTree(typ reflect.Type, paths []string) (*expandNode, error) {
+	root := &expandNode{children: make(map[string]*expandNode)}
+	for _, path := range paths {
+		segments := strings.Split(path, ".")
+		if len(segments) > MaxExpandDepth {
+			return nil, fmt.Errorf("%w: %q is more than %d fields deep", ErrInvalidExpand, path, MaxExpandDepth)
+		}
+
+		node, t := root, typ
+		for _, segment := range segments {
+			field, ok := jsonField(expandedType(t), segment)
+			if !ok {
+				return nil, fmt.Errorf("%w: %q has no field %q", ErrInvalidExpand, path, segment)
+			}
+
+			child, ok := node.children[segment]
+			if !ok {
+				child = &expandNode{children: make(map[string]*expandNode)}
+				node.children[segment] = child
+			}
+			node, t = child, field.Type
+		}
+	}
+	return root, nil
+}
+
+// expandedType returns the struct type that fields are looked up in for the given type, following pointers, slices
+// and references.
+func expandedType(t reflect.Type) reflect.Type {
+	for {
+		switch {
+		case t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice:
+			t = t.Elem()
+		case isExpandable(t):
+			t = reflect.New(t).Interface().(expandable).resourceType()
+		default:
+			return t
+		}
+	}
+}
+
+func isExpandable(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && reflect.PointerTo(t).Implements(reflect.TypeFor[expandable]())
+}
+
+// jsonField returns the field of the struct type with the given JSON name.
+func jsonField(t reflect.Type, name string) (reflect.StructField, bool) {
+	if t.Kind() != reflect.Struct {
+		return reflect.StructField{}, false
+	}
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+		tag, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if field.IsExported() && tag == name {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// walk expands the references in v (which must be addressable) that are selected by the node.
+func (e *expander) walk(ctx context.Context, v reflect.Value, node *expandNode) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			e.walk(ctx, v.Elem(), node)
+		}
+	case reflect.Slice:
+		for i := range v.Len() {
+			e.walk(ctx, v.Index(i), node)
+		}
+	case reflect.Struct:
+		if ref, ok := v.Addr().Interface().(expandable); ok {
+			e.wg.Add(1)
+			go e.expandRef(ctx, ref, node)
+			return
+		}
+
+		if len(node.children) == 0 {
+			// the path ends on an object, so expand the references directly inside it
+			for i := range v.NumField() {
+				if v.Type().Field(i).IsExported() {
+					e.walkRefs(ctx, v.Field(i), node)
+				}
+			}
+			return
+		}
+
+		for name, child := range node.children {
+			field, _ := jsonField(v.Type(), name)
+			e.walk(ctx, v.FieldByIndex(field.Index), child)
+		}
+	}
+}
+
+// walkRefs expands the references in v, following pointers and slices but not other objects, so that only the
+// references directly inside an object are expanded.
+func (e *expander) walkRefs(ctx context.Context, v reflect.Value, node *expandNode) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			e.walkRefs(ctx, v.Elem(), node)
+		}
+	case reflect.Slice:
+		for i := range v.Len() {
+			e.walkRefs(ctx, v.Index(i), node)
+		}
+	case reflect.Struct:
+		if isExpandable(v.Type()) {
+			e.walk(ctx, v, node)
+		}
+	}
+}
+
+func (e *expander) expandRef(ctx context.Context, ref expandable, node *expandNode) {
+	defer e.wg.Done()
+
+	url := ref.ref().URL
+	if url == "" {
+		return
+	}
+
+	resource, err := e.fetch(ctx, url, ref.resourceType())
+	if err != nil {
+		e.fail(err)
+		return
+	}
+
+	target := expandTarget{url: url, node: node}
+	e.mu.Lock()
+	ref.expand(resource.Interface())
+	walked := e.walked[target]
+	e.walked[target] = true
+	e.mu.Unlock()
+
+	if !walked && len(node.children) > 0 {
+		e.walk(ctx, resource.Elem(), node)
+	}
+}
+
+// fetch retrieves the resource at the given URL, waiting for it if it's already being fetched.
+func (e *expander) fetch(ctx context.Context, url string, typ reflect.Type) (reflect.Value, error) {
+	e.mu.Lock()
+	f, ok := e.fetches[url]
+	if !ok {
+		f = &expandFetch{done: make(chan struct{})}
+		e.fetches[url] = f
+	}
+	e.mu.Unlock()
+
+	if ok {
+		select {
+		case <-f.done:
+			return f.resource, f.err
+		case <-ctx.Done():
+			return reflect.Value{}, ctx.Err()
+		}
+	}
+
+	defer close(f.done)
+	select {
+	case e.sem <- struct{}{}:
+	case <-ctx.Done():
+		f.err = ctx.Err()
+		return reflect.Value{}, f.err
+	}
+	defer func() { <-e.sem }()
+
+	resource, _ := urlutil.ResourceFromURL(url)
+	f.resource = reflect.New(typ)
+	f.err = getResource(ctx, e.cfg, resourceInfoFor("/"+resource), url, f.resource.Interface())
+	return f.resource, f.err
+}
+
+// fail records the first error that occurs and stops any other fetches.
+func (e *expander) fail(err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.err == nil {
+		e.err = err
+		e.cancel()
+	}
+}
@@ -0,0 +1,165 @@
+package pokesdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jameshalsall/pokesdk/internal/backend"
+)
+
+func TestExpand(t *testing.T) {
+	ctx := context.Background()
+
+	pikachu := func(args mock.Arguments) {
+		*args.Get(3).(*Pokemon) = Pokemon{
+			ID:      25,
+			Name:    "pikachu",
+			Species: SpeciesRef{Name: "pikachu", URL: "http://example.com/pokemon-species/25/"},
+			Types: []PokemonType{
+				{Slot: 1, Type: TypeRef{Name: "electric", URL: "http://example.com/type/13/"}},
+			},
+			Abilities: []PokemonAbility{
+				{Slot: 1, Ability: AbilityRef{Name: "static", URL: "http://example.com/ability/9/"}},
+				{Slot: 3, Ability: AbilityRef{Name: "lightning-rod", URL: "http://example.com/ability/31/"}},
+			},
+		}
+	}
+
+	t.Run("it should attach the resources referenced by each path", func(t *testing.T) {
+		client, mocks := newPokemonApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon/pikachu", map[string]string(nil), mock.Anything).Return(nil).Run(pikachu).Once()
+		mocks.backend.On("Process", mock.Anything, "http://example.com/pokemon-species/25/", map[string]string(nil), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(3).(*PokemonSpecies) = PokemonSpecies{ID: 25, Name: "pikachu", EvolutionChain: EvolutionChainRef{URL: "http://example.com/evolution-chain/10/"}}
+		}).Once()
+		mocks.backend.On("Process", mock.Anything, "http://example.com/evolution-chain/10/", map[string]string(nil), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(3).(*EvolutionChain) = EvolutionChain{ID: 10}
+		}).Once()
+		mocks.backend.On("Process", mock.Anything, "http://example.com/type/13/", map[string]string(nil), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(3).(*Type) = Type{ID: 13, Name: "electric"}
+		}).Once()
+		mocks.backend.On("Process", mock.Anything, "http://example.com/ability/9/", map[string]string(nil), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(3).(*Ability) = Ability{ID: 9, Name: "static"}
+		}).Once()
+		mocks.backend.On("Process", mock.Anything, "http://example.com/ability/31/", map[string]string(nil), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(3).(*Ability) = Ability{ID: 31, Name: "lightning-rod"}
+		}).Once()
+
+		pokemon, err := client.GetByName(ctx, "pikachu", Expand("species.evolution_chain", "types", "abilities"))
+
+		require.NoError(t, err)
+		species, ok := pokemon.Species.Expanded()
+		require.True(t, ok)
+		assert.Equal(t, "pikachu", species.Name)
+		chain, ok := species.EvolutionChain.Expanded()
+		require.True(t, ok)
+		assert.Equal(t, 10, chain.ID)
+		electric, ok := pokemon.Types[0].Type.Expanded()
+		require.True(t, ok)
+		assert.Equal(t, "electric", electric.Name)
+		static, ok := pokemon.Abilities[0].Ability.Expanded()
+		require.True(t, ok)
+		assert.Equal(t, "static", static.Name)
+		lightningRod, ok := pokemon.Abilities[1].Ability.Expanded()
+		require.True(t, ok)
+		assert.Equal(t, "lightning-rod", lightningRod.Name)
+		mocks.backend.AssertExpectations(t)
+	})
+
+	t.Run("it should only fetch a resource referenced more than once a single time", func(t *testing.T) {
+		client, mocks := newPokemonApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon/pikachu", map[string]string(nil), mock.Anything).Return(nil).Run(pikachu).Once()
+		mocks.backend.On("Process", mock.Anything, "http://example.com/pokemon-species/25/", map[string]string(nil), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(3).(*PokemonSpecies) = PokemonSpecies{ID: 25, Name: "pikachu", EvolutionChain: EvolutionChainRef{URL: "http://example.com/evolution-chain/10/"}}
+		}).Once()
+		mocks.backend.On("Process", mock.Anything, "http://example.com/evolution-chain/10/", map[string]string(nil), mock.Anything).Return(nil).Once()
+
+		pokemon, err := client.GetByName(ctx, "pikachu", Expand("species", "species.evolution_chain", "species"))
+
+		require.NoError(t, err)
+		species, ok := pokemon.Species.Expanded()
+		require.True(t, ok)
+		_, ok = species.EvolutionChain.Expanded()
+		assert.True(t, ok)
+		mocks.backend.AssertExpectations(t)
+	})
+
+	t.Run("it should only expand the references directly inside the objects a path ends on", func(t *testing.T) {
+		client, mocks := newPokemonApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon/pikachu", map[string]string(nil), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(3).(*Pokemon) = Pokemon{ID: 25, Name: "pikachu", Moves: []PokemonMove{{
+				Move: MoveRef{Name: "thunder", URL: "http://example.com/move/87/"},
+				VersionGroupDetails: []MoveVersionGroupDetail{{
+					VersionGroup:    VersionGroupRef{Name: "red-blue", URL: "http://example.com/version-group/1/"},
+					MoveLearnMethod: MoveLearnMethodRef{Name: "level-up", URL: "http://example.com/move-learn-method/1/"},
+				}},
+			}}}
+		}).Once()
+		mocks.backend.On("Process", mock.Anything, "http://example.com/move/87/", map[string]string(nil), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(3).(*Move) = Move{ID: 87, Name: "thunder"}
+		}).Once()
+
+		pokemon, err := client.GetByName(ctx, "pikachu", Expand("moves"))
+
+		require.NoError(t, err)
+		thunder, ok := pokemon.Moves[0].Move.Expanded()
+		require.True(t, ok)
+		assert.Equal(t, "thunder", thunder.Name)
+		_, ok = pokemon.Moves[0].VersionGroupDetails[0].VersionGroup.Expanded()
+		assert.False(t, ok)
+		mocks.backend.AssertExpectations(t)
+		mocks.backend.AssertNumberOfCalls(t, "Process", 2)
+	})
+
+	t.Run("it should return an error for invalid paths for every resource in GetMany without fetching anything", func(t *testing.T) {
+		client, mocks := newPokemonApiForTests(t)
+
+		results := client.GetMany(ctx, []string{"pikachu", "raichu"}, Expand("nickname"))
+
+		require.Len(t, results, 2)
+		for _, result := range results {
+			assert.ErrorIs(t, result.Error, ErrInvalidExpand)
+		}
+		mocks.backend.AssertNotCalled(t, "Process", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("it should return the not found error of a referenced resource", func(t *testing.T) {
+		client, mocks := newPokemonApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon/pikachu", map[string]string(nil), mock.Anything).Return(nil).Run(pikachu).Once()
+		mocks.backend.On("Process", mock.Anything, "http://example.com/pokemon-species/25/", map[string]string(nil), mock.Anything).Return(backend.ErrResourceNotFound).Once()
+
+		pokemon, err := client.GetByName(ctx, "pikachu", Expand("species"))
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrSpeciesNotFound)
+		assert.Nil(t, pokemon)
+	})
+
+	t.Run("it should return an error for invalid paths without fetching anything", func(t *testing.T) {
+		paths := map[string]string{
+			"an unknown field":        "nickname",
+			"an unknown nested path":  "species.trainer",
+			"an empty path":           "",
+			"a path that is too deep": "species.evolution_chain.chain.species.evolution_chain",
+		}
+
+		for name, path := range paths {
+			t.Run(name, func(t *testing.T) {
+				client, mocks := newPokemonApiForTests(t)
+
+				_, err := client.GetByName(ctx, "pikachu", Expand(path))
+
+				require.Error(t, err)
+				assert.ErrorIs(t, err, ErrInvalidExpand)
+				mocks.backend.AssertNotCalled(t, "Process", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			})
+		}
+	})
+}
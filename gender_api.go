@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiGenderPath = "/gender"
+)
+
+type GenderAPI struct {
+	ResourceAPI[Gender, GenderRef]
+}
+
+func newGenderAPI(cfg Config) GenderAPI {
+	return GenderAPI{newResourceAPI[Gender, GenderRef](cfg, apiGenderPath)}
+}
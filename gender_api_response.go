@@ -0,0 +1,17 @@
+package pokesdk
+
+type GenderRef = ResourceRef[Gender]
+
+type GenderList = ResourceList[GenderRef]
+
+type Gender struct {
+	ID                    int                    `json:"id"`
+	Name                  string                 `json:"name"`
+	PokemonSpeciesDetails []PokemonSpeciesGender `json:"pokemon_species_details"`
+	RequiredForEvolution  []SpeciesRef           `json:"required_for_evolution"`
+}
+
+type PokemonSpeciesGender struct {
+	Rate           int        `json:"rate"`
+	PokemonSpecies SpeciesRef `json:"pokemon_species"`
+}
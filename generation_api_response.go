@@ -1,34 +1,22 @@
 package pokesdk
 
-type GenerationRef NamedAPIResource
+type GenerationRef = ResourceRef[Generation]
 
-type GenerationList struct {
-	Count    int             `json:"count"`
-	Next     *string         `json:"next"`
-	Previous *string         `json:"previous"`
-	Results  []GenerationRef `json:"results"`
-}
-
-func (g *GenerationList) GetNextURL() string {
-	if g.Next == nil {
-		return ""
-	}
-	return *g.Next
-}
+type GenerationList = ResourceList[GenerationRef]
 
 type Generation struct {
-	ID             int                `json:"id"`
-	Name           string             `json:"name"`
-	Abilities      []NamedAPIResource `json:"abilities"`
-	MainRegion     NamedAPIResource   `json:"main_region"`
-	Moves          []NamedAPIResource `json:"moves"`
-	Names          []LocalizedName    `json:"names"`
-	PokemonSpecies []NamedAPIResource `json:"pokemon_species"`
-	Types          []NamedAPIResource `json:"types"`
-	VersionGroups  []NamedAPIResource `json:"version_groups"`
+	ID             int               `json:"id"`
+	Name           string            `json:"name"`
+	Abilities      []AbilityRef      `json:"abilities"`
+	MainRegion     RegionRef         `json:"main_region"`
+	Moves          []MoveRef         `json:"moves"`
+	Names          LocalizedNames    `json:"names"`
+	PokemonSpecies []SpeciesRef      `json:"pokemon_species"`
+	Types          []TypeRef         `json:"types"`
+	VersionGroups  []VersionGroupRef `json:"version_groups"`
 }
 
 type LocalizedName struct {
-	Language NamedAPIResource `json:"language"`
-	Name     string           `json:"name"`
+	Language LanguageRef `json:"language"`
+	Name     string      `json:"name"`
 }
@@ -224,5 +224,5 @@ func newGenerationApiForTests(t *testing.T) (GenerationAPI, generationApiMocks)
 		backend: &pokesdktest.MockBackend{},
 	}
 
-	return GenerationAPI{cfg: Config{baseURL: "http://example.com/", backend: mocks.backend}}, mocks
+	return newGenerationAPI(Config{baseURL: "http://example.com/", backend: mocks.backend}), mocks
 }
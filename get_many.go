@@ -0,0 +1,83 @@
+package pokesdk
+
+import (
+	"context"
+	"strconv"
+	"sync"
+)
+
+// defaultGetManyConcurrency is the number of resources GetMany fetches at once unless MaxConcurrency is given.
+const defaultGetManyConcurrency = 10
+
+// Result is the outcome of getting a single resource with GetMany.
+// It contains the resource, or the error that occurred while fetching it.
+type Result[T any] struct {
+	Data  *T
+	Error error
+}
+
+// MaxConcurrency sets the maximum number of resources that GetMany fetches at once. It defaults to 10, and has no
+// effect on the other getters.
+func MaxConcurrency(n int) GetOption {
+	return func(opts *getOptions) {
+		if n > 0 {
+			opts.concurrency = n
+		}
+	}
+}
+
+// GetMany retrieves the resources with the given names or IDs in parallel, along with any other options such as
+// Expand. The results are in the same order as the names, and a resource that can't be fetched has its error set in
+// its Result without affecting the others.
+func (r ResourceAPI[T, R]) GetMany(ctx context.Context, namesOrIDs []string, opts ...GetOption) []Result[T] {
+	urls := make([]string, len(namesOrIDs))
+	for i, nameOrID := range namesOrIDs {
+		urls[i] = r.url(r.path + "/" + nameOrID)
+	}
+	return r.getMany(ctx, urls, opts)
+}
+
+// GetManyByRef retrieves the resources with the given references in parallel, such as the results of a page from
+// List(). It works the same way as GetMany.
+func (r ResourceAPI[T, R]) GetManyByRef(ctx context.Context, refs []R, opts ...GetOption) []Result[T] {
+	urls := make([]string, len(refs))
+	for i, ref := range refs {
+		urls[i] = ref.ref().URL
+	}
+	return r.getMany(ctx, urls, opts)
+}
+
+// getManyByID retrieves the resources with the given IDs in parallel, for the APIs of unnamed resources.
+func (r ResourceAPI[T, R]) getManyByID(ctx context.Context, IDs []int, opts []GetOption) []Result[T] {
+	urls := make([]string, len(IDs))
+	for i, ID := range IDs {
+		urls[i] = r.url(r.path + "/" + strconv.Itoa(ID))
+	}
+	return r.getMany(ctx, urls, opts)
+}
+
+func (r ResourceAPI[T, R]) getMany(ctx context.Context, urls []string, opts []GetOption) []Result[T] {
+	results := make([]Result[T], len(urls))
+	sem := make(chan struct{}, newGetOptions(opts).concurrency)
+
+	var wg sync.WaitGroup
+	for i, url := range urls {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Error = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			results[i].Data, results[i].Error = r.get(ctx, url, opts...)
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
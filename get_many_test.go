@@ -0,0 +1,114 @@
+package pokesdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jameshalsall/pokesdk/internal/backend"
+)
+
+func TestResourceAPI_GetMany(t *testing.T) {
+	ctx := context.Background()
+
+	pokemonNamed := func(name string) func(args mock.Arguments) {
+		return func(args mock.Arguments) {
+			*args.Get(3).(*Pokemon) = Pokemon{Name: name}
+		}
+	}
+
+	t.Run("it should return the results in the order of the names", func(t *testing.T) {
+		client, mocks := newPokemonApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon/pikachu", map[string]string(nil), mock.Anything).Return(nil).Run(pokemonNamed("pikachu")).Once()
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon/4", map[string]string(nil), mock.Anything).Return(nil).Run(pokemonNamed("charmander")).Once()
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon/mew", map[string]string(nil), mock.Anything).Return(nil).Run(pokemonNamed("mew")).Once()
+
+		results := client.GetMany(ctx, []string{"pikachu", "4", "mew"}, MaxConcurrency(2))
+
+		require.Len(t, results, 3)
+		for i, name := range []string{"pikachu", "charmander", "mew"} {
+			require.NoError(t, results[i].Error)
+			assert.Equal(t, name, results[i].Data.Name)
+		}
+		mocks.backend.AssertExpectations(t)
+	})
+
+	t.Run("it should report the error of each resource without failing the others", func(t *testing.T) {
+		client, mocks := newPokemonApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon/pikachu", map[string]string(nil), mock.Anything).Return(nil).Run(pokemonNamed("pikachu")).Once()
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon/missingno", map[string]string(nil), mock.Anything).Return(backend.ErrResourceNotFound).Once()
+
+		results := client.GetMany(ctx, []string{"missingno", "pikachu"})
+
+		require.Len(t, results, 2)
+		assert.ErrorIs(t, results[0].Error, ErrPokemonNotFound)
+		assert.Nil(t, results[0].Data)
+		require.NoError(t, results[1].Error)
+		assert.Equal(t, "pikachu", results[1].Data.Name)
+	})
+
+	t.Run("it should get the resources by reference", func(t *testing.T) {
+		client, mocks := newPokemonApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon/25/", map[string]string(nil), mock.Anything).Return(nil).Run(pokemonNamed("pikachu")).Once()
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon/151/", map[string]string(nil), mock.Anything).Return(nil).Run(pokemonNamed("mew")).Once()
+
+		results := client.GetManyByRef(ctx, []PokemonRef{
+			{Name: "pikachu", URL: "http://example.com/pokemon/25/"},
+			{Name: "mew", URL: "http://example.com/pokemon/151/"},
+		})
+
+		require.Len(t, results, 2)
+		assert.Equal(t, "pikachu", results[0].Data.Name)
+		assert.Equal(t, "mew", results[1].Data.Name)
+		mocks.backend.AssertExpectations(t)
+	})
+
+	t.Run("it should get unnamed resources by ID", func(t *testing.T) {
+		client, mocks := newMachineApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/machine/1", map[string]string(nil), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(3).(*Machine) = Machine{ID: 1}
+		}).Once()
+		mocks.backend.On("Process", ctx, "http://example.com/machine/2", map[string]string(nil), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(3).(*Machine) = Machine{ID: 2}
+		}).Once()
+
+		results := client.GetMany(ctx, []int{1, 2})
+
+		require.Len(t, results, 2)
+		assert.Equal(t, 1, results[0].Data.ID)
+		assert.Equal(t, 2, results[1].Data.ID)
+		mocks.backend.AssertExpectations(t)
+	})
+
+	t.Run("it should return no results for no names", func(t *testing.T) {
+		client, _ := newPokemonApiForTests(t)
+
+		assert.Empty(t, client.GetMany(ctx, nil))
+	})
+}
+
+func TestMaxConcurrency(t *testing.T) {
+	tests := map[string]struct {
+		opts []GetOption
+		want int
+	}{
+		"no option":         {opts: nil, want: defaultGetManyConcurrency},
+		"a positive limit":  {opts: []GetOption{MaxConcurrency(3)}, want: 3},
+		"a limit of zero":   {opts: []GetOption{MaxConcurrency(0)}, want: defaultGetManyConcurrency},
+		"a negative limit":  {opts: []GetOption{MaxConcurrency(-1)}, want: defaultGetManyConcurrency},
+		"the last one wins": {opts: []GetOption{MaxConcurrency(3), MaxConcurrency(5)}, want: 5},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, newGetOptions(tt.opts).concurrency)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiGrowthRatePath = "/growth-rate"
+)
+
+type GrowthRateAPI struct {
+	ResourceAPI[GrowthRate, GrowthRateRef]
+}
+
+func newGrowthRateAPI(cfg Config) GrowthRateAPI {
+	return GrowthRateAPI{newResourceAPI[GrowthRate, GrowthRateRef](cfg, apiGrowthRatePath)}
+}
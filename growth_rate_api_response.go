@@ -0,0 +1,40 @@
+package pokesdk
+
+type GrowthRateRef = ResourceRef[GrowthRate]
+
+type GrowthRateList = ResourceList[GrowthRateRef]
+
+type GrowthRate struct {
+	ID             int                         `json:"id"`
+	Name           string                      `json:"name"`
+	Formula        string                      `json:"formula"`
+	Descriptions   []Description               `json:"descriptions"`
+	Levels         []GrowthRateExperienceLevel `json:"levels"`
+	PokemonSpecies []SpeciesRef                `json:"pokemon_species"`
+}
+
+type GrowthRateExperienceLevel struct {
+	Level      int `json:"level"`
+	Experience int `json:"experience"`
+}
+
+// LevelForExperience returns the level that a Pokemon with the given total experience points is at.
+func (g *GrowthRate) LevelForExperience(experience int) int {
+	level := MinLevel
+	for _, l := range g.Levels {
+		if l.Experience <= experience && l.Level > level {
+			level = l.Level
+		}
+	}
+	return level
+}
+
+// ExperienceForLevel returns the total experience points required to reach the given level.
+func (g *GrowthRate) ExperienceForLevel(level int) (int, bool) {
+	for _, l := range g.Levels {
+		if l.Level == level {
+			return l.Experience, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,55 @@
+package pokesdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jameshalsall/pokesdk/pokesdktest"
+)
+
+func TestGrowthRate_Experience(t *testing.T) {
+	// the first few levels of the medium-fast growth rate (experience = level^3)
+	growthRate := &GrowthRate{
+		Name: "medium",
+		Levels: []GrowthRateExperienceLevel{
+			{Level: 1, Experience: 0},
+			{Level: 2, Experience: 8},
+			{Level: 3, Experience: 27},
+			{Level: 4, Experience: 64},
+			{Level: 5, Experience: 125},
+		},
+	}
+
+	t.Run("it converts total experience to a level", func(t *testing.T) {
+		assert.Equal(t, 1, growthRate.LevelForExperience(0))
+		assert.Equal(t, 1, growthRate.LevelForExperience(7))
+		assert.Equal(t, 2, growthRate.LevelForExperience(8))
+		assert.Equal(t, 4, growthRate.LevelForExperience(124))
+		assert.Equal(t, 5, growthRate.LevelForExperience(125))
+		assert.Equal(t, 5, growthRate.LevelForExperience(1000))
+	})
+
+	t.Run("it returns the experience required for a level", func(t *testing.T) {
+		experience, ok := growthRate.ExperienceForLevel(3)
+		assert.True(t, ok)
+		assert.Equal(t, 27, experience)
+
+		_, ok = growthRate.ExperienceForLevel(6)
+		assert.False(t, ok)
+	})
+}
+
+type growthRateApiMocks struct {
+	backend *pokesdktest.MockBackend
+}
+
+func newGrowthRateApiForTests(t *testing.T) (GrowthRateAPI, growthRateApiMocks) {
+	t.Helper()
+
+	mocks := growthRateApiMocks{
+		backend: &pokesdktest.MockBackend{},
+	}
+
+	return newGrowthRateAPI(Config{baseURL: "http://example.com/", backend: mocks.backend}), mocks
+}
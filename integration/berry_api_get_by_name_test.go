@@ -0,0 +1,69 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jameshalsall/pokesdk"
+)
+
+func TestBerryGetByName(t *testing.T) {
+	server := NewMockServer(t)
+	defer server.Close()
+
+	ctx := context.Background()
+
+	t.Run("it returns a specific berry by name and resolves its item", func(t *testing.T) {
+		server.StubGET("/berry/cheri", Response{
+			StatusCode: 200,
+			Body:       ResponseBytes(berryResponse, server.URL()),
+		})
+		server.StubGET("/item/126/", Response{
+			StatusCode: 200,
+			Body:       itemResponse,
+		})
+
+		client := server.PokeSDKClient()
+
+		berry, err := client.Berry.GetByName(ctx, "cheri")
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, berry.ID)
+		assert.Equal(t, "cheri", berry.Name)
+		assert.Equal(t, 3, berry.GrowthTime)
+		assert.Equal(t, 5, berry.MaxHarvest)
+		assert.Equal(t, 60, berry.NaturalGiftPower)
+		assert.Equal(t, "fire", berry.NaturalGiftType.Name)
+		assert.Equal(t, "soft", berry.Firmness.Name)
+		require.Len(t, berry.Flavors, 2)
+		assert.Equal(t, "spicy", berry.Flavors[0].Flavor.Name)
+		assert.Equal(t, 10, berry.Flavors[0].Potency)
+
+		item, err := client.Item.GetByBerry(ctx, berry)
+		require.NoError(t, err)
+
+		assert.Equal(t, 126, item.ID)
+		assert.Equal(t, "cheri-berry", item.Name)
+		assert.Equal(t, 80, item.Cost)
+		assert.Equal(t, "medicine", item.Category.Name)
+
+		reqs := server.Requests()
+		require.Len(t, reqs, 2)
+		assert.Equal(t, "/berry/cheri", reqs[0].Path)
+		assert.Equal(t, "/item/126/", reqs[1].Path)
+	})
+
+	t.Run("it returns an error if berry not found", func(t *testing.T) {
+		server.Reset()
+		client := server.PokeSDKClient()
+
+		_, err := client.Berry.GetByName(ctx, "nonexistent")
+		require.Error(t, err)
+		assert.ErrorIs(t, err, pokesdk.ErrBerryNotFound)
+	})
+}
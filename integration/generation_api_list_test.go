@@ -63,18 +63,18 @@ func TestGenerationAPIList(t *testing.T) {
 		pages := client.Generation.List()
 
 		var pageCount int
-		for page := range pages.All(ctx) {
+		for page, err := range pages.Pages(ctx) {
 			pageCount++
-			require.NotNil(t, page)
-			require.Len(t, page.Data.Results, 1)
+			require.NoError(t, err)
+			require.Len(t, page.Results, 1)
 
 			switch pageCount {
 			case 1:
-				assert.Equal(t, "generation-i", page.Data.Results[0].Name)
-				assert.Equal(t, "https://pokeapi.co/api/v2/generation/1/", page.Data.Results[0].URL)
+				assert.Equal(t, "generation-i", page.Results[0].Name)
+				assert.Equal(t, "https://pokeapi.co/api/v2/generation/1/", page.Results[0].URL)
 			case 2:
-				assert.Equal(t, "generation-ii", page.Data.Results[0].Name)
-				assert.Equal(t, "https://pokeapi.co/api/v2/generation/2/", page.Data.Results[0].URL)
+				assert.Equal(t, "generation-ii", page.Results[0].Name)
+				assert.Equal(t, "https://pokeapi.co/api/v2/generation/2/", page.Results[0].URL)
 			default:
 				t.Fatalf("unexpected page count: %d", pageCount)
 			}
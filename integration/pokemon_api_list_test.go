@@ -63,21 +63,36 @@ func TestPokemonAPIList(t *testing.T) {
 		pages := client.Pokemon.List()
 
 		var pageCount int
-		for page := range pages.All(ctx) {
+		for page, err := range pages.Pages(ctx) {
 			pageCount++
-			require.NotNil(t, page)
-			require.Len(t, page.Data.Results, 1)
+			require.NoError(t, err)
+			require.Len(t, page.Results, 1)
 
 			switch pageCount {
 			case 1:
-				assert.Equal(t, "bulbasaur", page.Data.Results[0].Name)
-				assert.Equal(t, "https://pokeapi.co/api/v2/pokemon/1/", page.Data.Results[0].URL)
+				assert.Equal(t, "bulbasaur", page.Results[0].Name)
+				assert.Equal(t, "https://pokeapi.co/api/v2/pokemon/1/", page.Results[0].URL)
 			case 2:
-				assert.Equal(t, "ivysaur", page.Data.Results[0].Name)
-				assert.Equal(t, "https://pokeapi.co/api/v2/pokemon/2/", page.Data.Results[0].URL)
+				assert.Equal(t, "ivysaur", page.Results[0].Name)
+				assert.Equal(t, "https://pokeapi.co/api/v2/pokemon/2/", page.Results[0].URL)
 			default:
 				t.Fatalf("unexpected page count: %d", pageCount)
 			}
 		}
 	})
+
+	t.Run("it iterates over the pokemon on every page", func(t *testing.T) {
+		server.ResetRequests()
+
+		client := server.PokeSDKClient()
+
+		var names []string
+		for ref, err := range client.Pokemon.List().Items(ctx) {
+			require.NoError(t, err)
+			names = append(names, ref.Name)
+		}
+
+		assert.Equal(t, []string{"bulbasaur", "ivysaur"}, names)
+		assert.Len(t, server.Requests(), 2)
+	})
 }
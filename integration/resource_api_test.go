@@ -0,0 +1,50 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jameshalsall/pokesdk"
+)
+
+type customSpecies struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	CaptureRate int    `json:"capture_rate"`
+}
+
+func TestNewResourceAPI(t *testing.T) {
+	server := NewMockServer(t)
+	defer server.Close()
+
+	ctx := context.Background()
+	api := pokesdk.NewResourceAPI[customSpecies]("/pokemon-species", pokesdk.WithCustomBaseURL(server.URL()))
+
+	t.Run("it decodes a custom resource type", func(t *testing.T) {
+		server.StubGET("/pokemon-species/venusaur", Response{
+			StatusCode: http.StatusOK,
+			Body:       pokemonSpeciesResponse,
+		})
+
+		species, err := api.GetByName(ctx, "venusaur")
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, species.ID)
+		assert.Equal(t, "venusaur", species.Name)
+		assert.Equal(t, 45, species.CaptureRate)
+	})
+
+	t.Run("it returns an error if the resource is not found", func(t *testing.T) {
+		server.Reset()
+
+		_, err := api.GetByName(ctx, "nonexistent")
+		require.Error(t, err)
+		assert.ErrorIs(t, err, pokesdk.ErrNotFound)
+	})
+}
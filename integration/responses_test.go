@@ -20,6 +20,14 @@ var (
 	listGenerationResponsePage1 []byte
 	//go:embed testdata/list_generation_response_page_2.json
 	listGenerationResponsePage2 []byte
+
+	//go:embed testdata/pokemon_species_response.json
+	pokemonSpeciesResponse []byte
+
+	//go:embed testdata/berry_response.json
+	berryResponse []byte
+	//go:embed testdata/item_response.json
+	itemResponse []byte
 )
 
 func ResponseBytes(tpl []byte, baseURL string) []byte {
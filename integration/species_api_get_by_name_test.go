@@ -0,0 +1,68 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jameshalsall/pokesdk"
+)
+
+func TestSpeciesGetByName(t *testing.T) {
+	server := NewMockServer(t)
+	defer server.Close()
+
+	ctx := context.Background()
+
+	server.StubGET("/pokemon-species/venusaur", Response{
+		StatusCode: 200,
+		Body:       pokemonSpeciesResponse,
+	})
+
+	t.Run("it returns a specific pokemon species by name", func(t *testing.T) {
+		client := server.PokeSDKClient()
+
+		species, err := client.Species.GetByName(ctx, "venusaur")
+		require.NoError(t, err)
+
+		assert.NotNil(t, species)
+		assert.Equal(t, "venusaur", species.Name)
+		assert.Equal(t, 3, species.ID)
+		assert.Equal(t, 1, species.GenderRate)
+		assert.Equal(t, 45, species.CaptureRate)
+		assert.Equal(t, "medium-slow", species.GrowthRate.Name)
+		assert.Equal(t, "https://pokeapi.co/api/v2/evolution-chain/1/", species.EvolutionChain.URL)
+		require.NotNil(t, species.EvolvesFromSpecies)
+		assert.Equal(t, "ivysaur", species.EvolvesFromSpecies.Name)
+		require.Len(t, species.EggGroups, 2)
+		assert.Equal(t, "monster", species.EggGroups[0].Name)
+		require.Len(t, species.Genera, 1)
+		assert.Equal(t, "Seed Pokémon", species.Genera[0].Genus)
+		require.Len(t, species.FlavorTextEntries, 1)
+		assert.Equal(t, "red", species.FlavorTextEntries[0].Version.Name)
+		require.Len(t, species.Varieties, 2)
+		assert.True(t, species.Varieties[0].IsDefault)
+		assert.Equal(t, "venusaur-mega", species.Varieties[1].Pokemon.Name)
+
+		reqs := server.Requests()
+		require.Len(t, reqs, 1)
+		assert.Equal(t, "/pokemon-species/venusaur", reqs[0].Path)
+	})
+
+	t.Run("it returns an error if pokemon species not found", func(t *testing.T) {
+		server.Reset()
+		client := server.PokeSDKClient()
+
+		_, err := client.Species.GetByName(ctx, "nonexistent")
+		require.Error(t, err)
+		assert.ErrorIs(t, err, pokesdk.ErrSpeciesNotFound)
+
+		reqs := server.Requests()
+		require.Len(t, reqs, 1)
+		assert.Equal(t, "/pokemon-species/nonexistent", reqs[0].Path)
+	})
+}
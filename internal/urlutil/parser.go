@@ -1,6 +1,10 @@
 package urlutil
 
-import "net/url"
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
 
 func PathFromURL(rawUrl string) (string, bool) {
 	parsedUrl, err := url.Parse(rawUrl)
@@ -9,3 +13,34 @@ func PathFromURL(rawUrl string) (string, bool) {
 	}
 	return parsedUrl.Path + "?" + parsedUrl.RawQuery, true
 }
+
+// IDFromURL returns the numeric ID at the end of a PokeAPI resource URL, e.g. 25 for
+// https://pokeapi.co/api/v2/pokemon/25/
+func IDFromURL(rawUrl string) (int, bool) {
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return 0, false
+	}
+
+	segments := strings.Split(strings.Trim(parsedUrl.Path, "/"), "/")
+	id, err := strconv.Atoi(segments[len(segments)-1])
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
+// ResourceFromURL returns the name of the resource that a PokeAPI resource URL points to, e.g. ability for
+// https://pokeapi.co/api/v2/ability/65/
+func ResourceFromURL(rawUrl string) (string, bool) {
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return "", false
+	}
+
+	segments := strings.Split(strings.Trim(parsedUrl.Path, "/"), "/")
+	if len(segments) < 2 || segments[len(segments)-2] == "" {
+		return "", false
+	}
+	return segments[len(segments)-2], true
+}
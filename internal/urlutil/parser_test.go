@@ -23,3 +23,53 @@ func TestPathFromURL(t *testing.T) {
 		assert.Equal(t, "", got)
 	})
 }
+
+func TestIDFromURL(t *testing.T) {
+	t.Run("returns the ID from a URL with a trailing slash", func(t *testing.T) {
+		got, ok := IDFromURL("https://pokeapi.co/api/v2/pokemon/25/")
+		assert.True(t, ok)
+		assert.Equal(t, 25, got)
+	})
+
+	t.Run("returns the ID from a URL without a trailing slash", func(t *testing.T) {
+		got, ok := IDFromURL("https://pokeapi.co/api/v2/generation/3")
+		assert.True(t, ok)
+		assert.Equal(t, 3, got)
+	})
+
+	t.Run("returns false if the URL does not end in an ID", func(t *testing.T) {
+		got, ok := IDFromURL("https://pokeapi.co/api/v2/pokemon/pikachu/")
+		assert.False(t, ok)
+		assert.Equal(t, 0, got)
+	})
+
+	t.Run("returns false for an invalid URL", func(t *testing.T) {
+		_, ok := IDFromURL(":adwad")
+		assert.False(t, ok)
+	})
+}
+
+func TestResourceFromURL(t *testing.T) {
+	t.Run("returns the resource from a URL with a trailing slash", func(t *testing.T) {
+		got, ok := ResourceFromURL("https://pokeapi.co/api/v2/ability/65/")
+		assert.True(t, ok)
+		assert.Equal(t, "ability", got)
+	})
+
+	t.Run("returns the resource from a URL without a trailing slash", func(t *testing.T) {
+		got, ok := ResourceFromURL("http://example.com/pokemon-species/bulbasaur")
+		assert.True(t, ok)
+		assert.Equal(t, "pokemon-species", got)
+	})
+
+	t.Run("returns false if the URL has no resource", func(t *testing.T) {
+		got, ok := ResourceFromURL("https://pokeapi.co/")
+		assert.False(t, ok)
+		assert.Equal(t, "", got)
+	})
+
+	t.Run("returns false for an invalid URL", func(t *testing.T) {
+		_, ok := ResourceFromURL(":adwad")
+		assert.False(t, ok)
+	})
+}
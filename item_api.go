@@ -0,0 +1,35 @@
+package pokesdk
+
+import "context"
+
+const (
+	apiItemPath = "/item"
+)
+
+type ItemAPI struct {
+	ResourceAPI[Item, ItemRef]
+}
+
+func newItemAPI(cfg Config) ItemAPI {
+	return ItemAPI{newResourceAPI[Item, ItemRef](cfg, apiItemPath)}
+}
+
+// GetByPokemon retrieves every Item the given Pokemon may be holding when encountered in the wild, along with the
+// rarity of each item per version.
+func (i ItemAPI) GetByPokemon(ctx context.Context, pokemon *Pokemon) ([]PokemonHeldItem, error) {
+	items := make([]PokemonHeldItem, 0, len(pokemon.HeldItems))
+	for _, held := range pokemon.HeldItems {
+		item, err := i.get(ctx, held.Item.URL)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, PokemonHeldItem{Item: item, VersionDetails: held.VersionDetails})
+	}
+
+	return items, nil
+}
+
+// GetByBerry retrieves the Item that the given Berry is.
+func (i ItemAPI) GetByBerry(ctx context.Context, berry *Berry) (*Item, error) {
+	return i.get(ctx, berry.Item.URL)
+}
@@ -0,0 +1,49 @@
+package pokesdk
+
+type ItemRef = ResourceRef[Item]
+
+type ItemList = ResourceList[ItemRef]
+
+type Item struct {
+	ID                int                      `json:"id"`
+	Name              string                   `json:"name"`
+	Cost              int                      `json:"cost"`
+	FlingPower        *int                     `json:"fling_power"`
+	FlingEffect       *ItemFlingEffectRef      `json:"fling_effect"`
+	Attributes        []ItemAttributeRef       `json:"attributes"`
+	Category          ItemCategoryRef          `json:"category"`
+	EffectEntries     []VerboseEffect          `json:"effect_entries"`
+	FlavorTextEntries []VersionGroupFlavorText `json:"flavor_text_entries"`
+	GameIndices       []GenerationGameIndex    `json:"game_indices"`
+	Names             LocalizedNames           `json:"names"`
+	Sprites           ItemSprites              `json:"sprites"`
+	HeldByPokemon     []ItemHolderPokemon      `json:"held_by_pokemon"`
+	BabyTriggerFor    *EvolutionChainRef       `json:"baby_trigger_for"`
+	Machines          []MachineVersionDetail   `json:"machines"`
+}
+
+type ItemSprites struct {
+	Default *string `json:"default"`
+}
+
+type ItemHolderPokemon struct {
+	Pokemon        PokemonRef       `json:"pokemon"`
+	VersionDetails []VersionDetails `json:"version_details"`
+}
+
+// PokemonHeldItem is an Item that a Pokemon may be holding when encountered in the wild, along with how likely it is
+// to be holding it in each version.
+type PokemonHeldItem struct {
+	Item           *Item
+	VersionDetails []VersionDetails
+}
+
+// Rarity returns how likely (as a percentage) the Pokemon is to be holding the item in the given version.
+func (h PokemonHeldItem) Rarity(version string) (int, bool) {
+	for _, detail := range h.VersionDetails {
+		if detail.Version.Name == version {
+			return detail.Rarity, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,107 @@
+package pokesdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jameshalsall/pokesdk/internal/backend"
+	"github.com/jameshalsall/pokesdk/pokesdktest"
+)
+
+func TestItemAPI_GetByPokemon(t *testing.T) {
+	ctx := context.Background()
+
+	pokemon := &Pokemon{
+		HeldItems: []HeldItems{
+			{
+				Item: ItemRef{Name: "oran-berry", URL: "http://example.com/item/132/"},
+				VersionDetails: []VersionDetails{
+					{Rarity: 50, Version: VersionRef{Name: "ruby"}},
+					{Rarity: 5, Version: VersionRef{Name: "emerald"}},
+				},
+			},
+		},
+	}
+
+	t.Run("it should get the items held by a pokemon with their rarity", func(t *testing.T) {
+		client, mocks := newItemApiForTests(t)
+		mocks.backend.HydrateWith([]byte(`{"id": 132, "name": "oran-berry", "cost": 20, "sprites": {"default": "https://example.com/oran-berry.png"}}`))
+
+		mocks.backend.On("Process", ctx, "http://example.com/item/132/", map[string]string(nil), mock.Anything).Return(nil).Once()
+
+		items, err := client.GetByPokemon(ctx, pokemon)
+
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, "oran-berry", items[0].Item.Name)
+		assert.Equal(t, 20, items[0].Item.Cost)
+		require.NotNil(t, items[0].Item.Sprites.Default)
+		assert.Equal(t, "https://example.com/oran-berry.png", *items[0].Item.Sprites.Default)
+
+		rarity, ok := items[0].Rarity("emerald")
+		assert.True(t, ok)
+		assert.Equal(t, 5, rarity)
+
+		_, ok = items[0].Rarity("red")
+		assert.False(t, ok)
+	})
+
+	t.Run("it should return an error if an item does not exist", func(t *testing.T) {
+		client, mocks := newItemApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/item/132/", map[string]string(nil), mock.Anything).Return(backend.ErrResourceNotFound).Once()
+
+		_, err := client.GetByPokemon(ctx, pokemon)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrItemNotFound)
+	})
+}
+
+func TestItemAPI_GetByBerry(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("it should get the item for a berry", func(t *testing.T) {
+		client, mocks := newItemApiForTests(t)
+		mocks.backend.HydrateWith([]byte(`{"id": 126, "name": "cheri-berry", "cost": 80}`))
+
+		mocks.backend.On("Process", ctx, "http://example.com/item/126/", map[string]string(nil), mock.Anything).Return(nil).Once()
+
+		berry := &Berry{ID: 1, Name: "cheri", Item: ItemRef{Name: "cheri-berry", URL: "http://example.com/item/126/"}}
+		item, err := client.GetByBerry(ctx, berry)
+
+		require.NoError(t, err)
+		assert.Equal(t, 126, item.ID)
+		assert.Equal(t, "cheri-berry", item.Name)
+		assert.Equal(t, 80, item.Cost)
+	})
+
+	t.Run("it should return an error if the item does not exist", func(t *testing.T) {
+		client, mocks := newItemApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/item/999/", map[string]string(nil), mock.Anything).Return(backend.ErrResourceNotFound).Once()
+
+		_, err := client.GetByBerry(ctx, &Berry{Item: ItemRef{URL: "http://example.com/item/999/"}})
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrItemNotFound)
+	})
+}
+
+type itemApiMocks struct {
+	backend *pokesdktest.MockBackend
+}
+
+func newItemApiForTests(t *testing.T) (ItemAPI, itemApiMocks) {
+	t.Helper()
+
+	mocks := itemApiMocks{
+		backend: &pokesdktest.MockBackend{},
+	}
+
+	return newItemAPI(Config{baseURL: "http://example.com/", backend: mocks.backend}), mocks
+}
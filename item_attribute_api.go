@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiItemAttributePath = "/item-attribute"
+)
+
+type ItemAttributeAPI struct {
+	ResourceAPI[ItemAttribute, ItemAttributeRef]
+}
+
+func newItemAttributeAPI(cfg Config) ItemAttributeAPI {
+	return ItemAttributeAPI{newResourceAPI[ItemAttribute, ItemAttributeRef](cfg, apiItemAttributePath)}
+}
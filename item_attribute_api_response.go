@@ -0,0 +1,13 @@
+package pokesdk
+
+type ItemAttributeRef = ResourceRef[ItemAttribute]
+
+type ItemAttributeList = ResourceList[ItemAttributeRef]
+
+type ItemAttribute struct {
+	ID           int            `json:"id"`
+	Name         string         `json:"name"`
+	Items        []ItemRef      `json:"items"`
+	Names        LocalizedNames `json:"names"`
+	Descriptions []Description  `json:"descriptions"`
+}
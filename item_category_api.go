@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiItemCategoryPath = "/item-category"
+)
+
+type ItemCategoryAPI struct {
+	ResourceAPI[ItemCategory, ItemCategoryRef]
+}
+
+func newItemCategoryAPI(cfg Config) ItemCategoryAPI {
+	return ItemCategoryAPI{newResourceAPI[ItemCategory, ItemCategoryRef](cfg, apiItemCategoryPath)}
+}
@@ -0,0 +1,13 @@
+package pokesdk
+
+type ItemCategoryRef = ResourceRef[ItemCategory]
+
+type ItemCategoryList = ResourceList[ItemCategoryRef]
+
+type ItemCategory struct {
+	ID     int            `json:"id"`
+	Name   string         `json:"name"`
+	Items  []ItemRef      `json:"items"`
+	Names  LocalizedNames `json:"names"`
+	Pocket ItemPocketRef  `json:"pocket"`
+}
@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiItemFlingEffectPath = "/item-fling-effect"
+)
+
+type ItemFlingEffectAPI struct {
+	ResourceAPI[ItemFlingEffect, ItemFlingEffectRef]
+}
+
+func newItemFlingEffectAPI(cfg Config) ItemFlingEffectAPI {
+	return ItemFlingEffectAPI{newResourceAPI[ItemFlingEffect, ItemFlingEffectRef](cfg, apiItemFlingEffectPath)}
+}
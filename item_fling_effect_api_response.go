@@ -0,0 +1,12 @@
+package pokesdk
+
+type ItemFlingEffectRef = ResourceRef[ItemFlingEffect]
+
+type ItemFlingEffectList = ResourceList[ItemFlingEffectRef]
+
+type ItemFlingEffect struct {
+	ID            int       `json:"id"`
+	Name          string    `json:"name"`
+	EffectEntries []Effect  `json:"effect_entries"`
+	Items         []ItemRef `json:"items"`
+}
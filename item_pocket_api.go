@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiItemPocketPath = "/item-pocket"
+)
+
+type ItemPocketAPI struct {
+	ResourceAPI[ItemPocket, ItemPocketRef]
+}
+
+func newItemPocketAPI(cfg Config) ItemPocketAPI {
+	return ItemPocketAPI{newResourceAPI[ItemPocket, ItemPocketRef](cfg, apiItemPocketPath)}
+}
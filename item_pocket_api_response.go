@@ -0,0 +1,12 @@
+package pokesdk
+
+type ItemPocketRef = ResourceRef[ItemPocket]
+
+type ItemPocketList = ResourceList[ItemPocketRef]
+
+type ItemPocket struct {
+	ID         int               `json:"id"`
+	Name       string            `json:"name"`
+	Categories []ItemCategoryRef `json:"categories"`
+	Names      LocalizedNames    `json:"names"`
+}
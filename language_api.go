@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiLanguagePath = "/language"
+)
+
+type LanguageAPI struct {
+	ResourceAPI[Language, LanguageRef]
+}
+
+func newLanguageAPI(cfg Config) LanguageAPI {
+	return LanguageAPI{newResourceAPI[Language, LanguageRef](cfg, apiLanguagePath)}
+}
@@ -0,0 +1,14 @@
+package pokesdk
+
+type LanguageRef = ResourceRef[Language]
+
+type LanguageList = ResourceList[LanguageRef]
+
+type Language struct {
+	ID       int            `json:"id"`
+	Name     string         `json:"name"`
+	Official bool           `json:"official"`
+	ISO639   string         `json:"iso639"`
+	ISO3166  string         `json:"iso3166"`
+	Names    LocalizedNames `json:"names"`
+}
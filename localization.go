@@ -0,0 +1,273 @@
+package pokesdk
+
+// DefaultLanguage is the language used for localized text when no other language is configured using WithLanguage.
+const DefaultLanguage = "en"
+
+// Localized is implemented by every resource that has localized names.
+// The methods take the languages to pick from explicitly. Use Client.LocalizedName to pick a name in the languages the
+// client was configured with using WithLanguage.
+type Localized interface {
+	LocalizedName(language string, fallbacks ...string) (string, bool)
+}
+
+// LocalizedFlavor is implemented by every resource that has localized flavor text.
+// Use Client.LocalizedFlavorText to pick flavor text in the languages the client was configured with.
+type LocalizedFlavor interface {
+	LocalizedFlavorText(language string, fallbacks ...string) (string, bool)
+}
+
+// LocalizedNames are the names of a resource in each of the languages it has been translated into.
+type LocalizedNames []LocalizedName
+
+// Get returns the name in the given language, or in the first of the fallbacks that it is available in, so the
+// languages act as a fallback chain (e.g. "ja-Hrkt", "ja", "en"). Languages are matched by their PokeAPI name.
+func (n LocalizedNames) Get(language string, fallbacks ...string) (string, bool) {
+	name, ok := pickLanguage(n, func(n LocalizedName) string { return n.Language.Name }, language, fallbacks)
+	return name.Name, ok
+}
+
+// flavorTextEntry is implemented by each of the types of flavor text entry in the PokeAPI: FlavorText,
+// AbilityFlavorText, MoveFlavorText and VersionGroupFlavorText.
+type flavorTextEntry interface {
+	languageName() string
+}
+
+func (f FlavorText) languageName() string             { return f.Language.Name }
+func (f AbilityFlavorText) languageName() string      { return f.Language.Name }
+func (f MoveFlavorText) languageName() string         { return f.Language.Name }
+func (f VersionGroupFlavorText) languageName() string { return f.Language.Name }
+
+// LocalizedFlavorText returns the first flavor text entry in the first of the given languages that has one, in the
+// same way as LocalizedNames.Get. It accepts the flavor text entries of any resource, e.g. species.FlavorTextEntries
+// or ability.FlavorTextEntries.
+func LocalizedFlavorText[F flavorTextEntry](entries []F, language string, fallbacks ...string) (F, bool) {
+	return pickLanguage(entries, F.languageName, language, fallbacks)
+}
+
+func pickLanguage[T any](items []T, languageOf func(T) string, language string, fallbacks []string) (T, bool) {
+	for _, lang := range append([]string{language}, fallbacks...) {
+		for _, item := range items {
+			if languageOf(item) == lang {
+				return item, true
+			}
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
+// LocalizedName returns the name of the ability in the first of the given languages that it is available in.
+func (a *Ability) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return a.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the berry firmness in the first of the given languages that it is available in.
+func (b *BerryFirmness) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return b.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the berry flavor in the first of the given languages that it is available in.
+func (b *BerryFlavor) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return b.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the contest type in the first of the given languages that it is available in.
+func (c *ContestType) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	name, ok := pickLanguage(c.Names, func(n ContestName) string { return n.Language.Name }, language, fallbacks)
+	return name.Name, ok
+}
+
+// LocalizedName returns the name of the egg group in the first of the given languages that it is available in.
+func (e *EggGroup) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return e.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the encounter condition in the first of the given languages that it is available in.
+func (e *EncounterCondition) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return e.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the encounter condition value in the first of the given languages that it is available in.
+func (e *EncounterConditionValue) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return e.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the encounter method in the first of the given languages that it is available in.
+func (e *EncounterMethod) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return e.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the generation in the first of the given languages that it is available in.
+func (g *Generation) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return g.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the item in the first of the given languages that it is available in.
+func (i *Item) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return i.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the item attribute in the first of the given languages that it is available in.
+func (i *ItemAttribute) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return i.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the item category in the first of the given languages that it is available in.
+func (i *ItemCategory) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return i.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the item pocket in the first of the given languages that it is available in.
+func (i *ItemPocket) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return i.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the language in the first of the given languages that it is available in.
+func (l *Language) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return l.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the location in the first of the given languages that it is available in.
+func (l *Location) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return l.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the location area in the first of the given languages that it is available in.
+func (l *LocationArea) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return l.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the move in the first of the given languages that it is available in.
+func (m *Move) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return m.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the move ailment in the first of the given languages that it is available in.
+func (m *MoveAilment) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return m.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the move battle style in the first of the given languages that it is available in.
+func (m *MoveBattleStyle) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return m.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the move damage class in the first of the given languages that it is available in.
+func (m *MoveDamageClass) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return m.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the move learn method in the first of the given languages that it is available in.
+func (m *MoveLearnMethod) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return m.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the move target in the first of the given languages that it is available in.
+func (m *MoveTarget) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return m.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the nature in the first of the given languages that it is available in.
+func (n *Nature) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return n.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the pal park area in the first of the given languages that it is available in.
+func (p *PalParkArea) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return p.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the pokeathlon stat in the first of the given languages that it is available in.
+func (p *PokeathlonStat) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return p.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the pokedex in the first of the given languages that it is available in.
+func (p *Pokedex) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return p.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the pokemon color in the first of the given languages that it is available in.
+func (p *PokemonColor) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return p.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the pokemon form in the first of the given languages that it is available in.
+func (p *PokemonForm) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return p.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the pokemon habitat in the first of the given languages that it is available in.
+func (p *PokemonHabitat) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return p.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the pokemon shape in the first of the given languages that it is available in.
+func (p *PokemonShape) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return p.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the pokemon species in the first of the given languages that it is available in.
+func (s *PokemonSpecies) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return s.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the region in the first of the given languages that it is available in.
+func (r *Region) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return r.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the stat in the first of the given languages that it is available in.
+func (s *Stat) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return s.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the type in the first of the given languages that it is available in.
+func (t *Type) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return t.Names.Get(language, fallbacks...)
+}
+
+// LocalizedName returns the name of the version in the first of the given languages that it is available in.
+func (v *Version) LocalizedName(language string, fallbacks ...string) (string, bool) {
+	return v.Names.Get(language, fallbacks...)
+}
+
+// LocalizedFlavorText returns the first flavor text of the ability in the first of the given languages that has one.
+func (a *Ability) LocalizedFlavorText(language string, fallbacks ...string) (string, bool) {
+	entry, ok := LocalizedFlavorText(a.FlavorTextEntries, language, fallbacks...)
+	return entry.FlavorText, ok
+}
+
+// LocalizedFlavorText returns the first flavor text of the contest effect in the first of the given languages that
+// has one.
+func (c *ContestEffect) LocalizedFlavorText(language string, fallbacks ...string) (string, bool) {
+	entry, ok := LocalizedFlavorText(c.FlavorTextEntries, language, fallbacks...)
+	return entry.FlavorText, ok
+}
+
+// LocalizedFlavorText returns the first flavor text of the item in the first of the given languages that has one.
+func (i *Item) LocalizedFlavorText(language string, fallbacks ...string) (string, bool) {
+	entry, ok := LocalizedFlavorText(i.FlavorTextEntries, language, fallbacks...)
+	return entry.Text, ok
+}
+
+// LocalizedFlavorText returns the first flavor text of the move in the first of the given languages that has one.
+func (m *Move) LocalizedFlavorText(language string, fallbacks ...string) (string, bool) {
+	entry, ok := LocalizedFlavorText(m.FlavorTextEntries, language, fallbacks...)
+	return entry.FlavorText, ok
+}
+
+// LocalizedFlavorText returns the first flavor text of the pokemon species in the first of the given languages that
+// has one.
+func (s *PokemonSpecies) LocalizedFlavorText(language string, fallbacks ...string) (string, bool) {
+	entry, ok := LocalizedFlavorText(s.FlavorTextEntries, language, fallbacks...)
+	return entry.FlavorText, ok
+}
+
+// LocalizedFlavorText returns the first flavor text of the super contest effect in the first of the given languages
+// that has one.
+func (s *SuperContestEffect) LocalizedFlavorText(language string, fallbacks ...string) (string, bool) {
+	entry, ok := LocalizedFlavorText(s.FlavorTextEntries, language, fallbacks...)
+	return entry.FlavorText, ok
+}
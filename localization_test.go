@@ -0,0 +1,183 @@
+package pokesdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalizedNames_Get(t *testing.T) {
+	names := LocalizedNames{
+		{Name: "フシギダネ", Language: LanguageRef{Name: "ja-Hrkt"}},
+		{Name: "Bulbasaur", Language: LanguageRef{Name: "en"}},
+		{Name: "Bulbizarre", Language: LanguageRef{Name: "fr"}},
+	}
+
+	tests := map[string]struct {
+		language  string
+		fallbacks []string
+		want      string
+		wantOK    bool
+	}{
+		"a single language":            {language: "fr", want: "Bulbizarre", wantOK: true},
+		"the first available language": {language: "ja-Hrkt", fallbacks: []string{"ja", "en"}, want: "フシギダネ", wantOK: true},
+		"a fallback language":          {language: "ja", fallbacks: []string{"fr", "en"}, want: "Bulbizarre", wantOK: true},
+		"no available language":        {language: "ko", fallbacks: []string{"zh-Hans"}, want: "", wantOK: false},
+		"an empty language":            {language: "", want: "", wantOK: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := names.Get(tt.language, tt.fallbacks...)
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantOK, ok)
+		})
+	}
+}
+
+func TestContestType_LocalizedName(t *testing.T) {
+	contestType := &ContestType{Names: []ContestName{
+		{Name: "Coolness", Color: "Red", Language: LanguageRef{Name: "en"}},
+		{Name: "Sang-froid", Color: "Rouge", Language: LanguageRef{Name: "fr"}},
+	}}
+
+	t.Run("it should return the name in the first available language", func(t *testing.T) {
+		name, ok := contestType.LocalizedName("de", "fr", "en")
+
+		assert.True(t, ok)
+		assert.Equal(t, "Sang-froid", name)
+	})
+
+	t.Run("it should report when no name is available", func(t *testing.T) {
+		_, ok := contestType.LocalizedName("ja")
+
+		assert.False(t, ok)
+	})
+
+	t.Run("it should be usable with the client's languages", func(t *testing.T) {
+		name, ok := NewClient(WithLanguage("fr")).LocalizedName(contestType)
+
+		assert.True(t, ok)
+		assert.Equal(t, "Sang-froid", name)
+	})
+}
+
+func TestLocalizedFlavorText(t *testing.T) {
+	entries := []FlavorText{
+		{FlavorText: "A strange seed was planted on its back at birth.", Language: LanguageRef{Name: "en"}, Version: &VersionRef{Name: "red"}},
+		{FlavorText: "It can go for days without eating a single morsel.", Language: LanguageRef{Name: "en"}, Version: &VersionRef{Name: "yellow"}},
+		{FlavorText: "Au matin de sa vie, la graine sur son dos lui fournit les éléments dont il a besoin pour grandir.", Language: LanguageRef{Name: "fr"}},
+	}
+
+	t.Run("it should return the first entry in the first available language", func(t *testing.T) {
+		got, ok := LocalizedFlavorText(entries, "de", "en")
+
+		assert.True(t, ok)
+		assert.Equal(t, "red", got.Version.Name)
+	})
+
+	t.Run("it should report when no entry is available", func(t *testing.T) {
+		_, ok := LocalizedFlavorText(entries, "de")
+
+		assert.False(t, ok)
+	})
+
+	t.Run("it should pick entries of every flavor text type", func(t *testing.T) {
+		ability, ok := LocalizedFlavorText([]AbilityFlavorText{
+			{FlavorText: "Kann Paralyse auslösen.", Language: LanguageRef{Name: "de"}},
+			{FlavorText: "May cause paralysis if touched.", Language: LanguageRef{Name: "en"}},
+		}, "en")
+		require.True(t, ok)
+		assert.Equal(t, "May cause paralysis if touched.", ability.FlavorText)
+
+		move, ok := LocalizedFlavorText([]MoveFlavorText{{FlavorText: "A powerful electric attack.", Language: LanguageRef{Name: "en"}}}, "fr", "en")
+		require.True(t, ok)
+		assert.Equal(t, "A powerful electric attack.", move.FlavorText)
+
+		item, ok := LocalizedFlavorText([]VersionGroupFlavorText{{Text: "Restores 20 HP.", Language: LanguageRef{Name: "en"}}}, "en")
+		require.True(t, ok)
+		assert.Equal(t, "Restores 20 HP.", item.Text)
+	})
+}
+
+func TestLocalizedFlavorText_Resources(t *testing.T) {
+	english := LanguageRef{Name: "en"}
+	french := LanguageRef{Name: "fr"}
+
+	tests := map[string]struct {
+		resource LocalizedFlavor
+		want     string
+	}{
+		"ability": {resource: &Ability{FlavorTextEntries: []AbilityFlavorText{
+			{FlavorText: "Peut paralyser.", Language: french},
+			{FlavorText: "May paralyze.", Language: english},
+		}}, want: "May paralyze."},
+		"contest effect": {resource: &ContestEffect{FlavorTextEntries: []FlavorText{{FlavorText: "Shows off.", Language: english}}}, want: "Shows off."},
+		"item":           {resource: &Item{FlavorTextEntries: []VersionGroupFlavorText{{Text: "Restores 20 HP.", Language: english}}}, want: "Restores 20 HP."},
+		"move":           {resource: &Move{FlavorTextEntries: []MoveFlavorText{{FlavorText: "A tackle.", Language: english}}}, want: "A tackle."},
+		"pokemon species": {resource: &PokemonSpecies{FlavorTextEntries: []FlavorText{
+			{FlavorText: "A strange seed.", Language: english},
+		}}, want: "A strange seed."},
+		"super contest effect": {resource: &SuperContestEffect{FlavorTextEntries: []FlavorText{{FlavorText: "Excites.", Language: english}}}, want: "Excites."},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := tt.resource.LocalizedFlavorText("de", "en")
+
+			assert.True(t, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_LocalizedName(t *testing.T) {
+	species := &PokemonSpecies{Names: LocalizedNames{
+		{Name: "Pikachu", Language: LanguageRef{Name: "en"}},
+		{Name: "ピカチュウ", Language: LanguageRef{Name: "ja"}},
+	}}
+
+	t.Run("it should use the default language", func(t *testing.T) {
+		name, ok := NewClient().LocalizedName(species)
+
+		assert.True(t, ok)
+		assert.Equal(t, "Pikachu", name)
+	})
+
+	t.Run("it should use the configured language chain", func(t *testing.T) {
+		name, ok := NewClient(WithLanguage("ja-Hrkt", "ja", "en")).LocalizedName(species)
+
+		assert.True(t, ok)
+		assert.Equal(t, "ピカチュウ", name)
+	})
+
+	t.Run("it should use the default language for a client without configured languages", func(t *testing.T) {
+		name, ok := (&Client{}).LocalizedName(species)
+
+		assert.True(t, ok)
+		assert.Equal(t, "Pikachu", name)
+	})
+}
+
+func TestClient_LocalizedFlavorText(t *testing.T) {
+	move := &Move{FlavorTextEntries: []MoveFlavorText{
+		{FlavorText: "A powerful electric attack.", Language: LanguageRef{Name: "en"}},
+		{FlavorText: "Une attaque électrique puissante.", Language: LanguageRef{Name: "fr"}},
+	}}
+
+	t.Run("it should use the default language", func(t *testing.T) {
+		text, ok := NewClient().LocalizedFlavorText(move)
+
+		assert.True(t, ok)
+		assert.Equal(t, "A powerful electric attack.", text)
+	})
+
+	t.Run("it should use the configured language chain", func(t *testing.T) {
+		text, ok := NewClient(WithLanguage("de", "fr")).LocalizedFlavorText(move)
+
+		assert.True(t, ok)
+		assert.Equal(t, "Une attaque électrique puissante.", text)
+	})
+}
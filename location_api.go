@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiLocationPath = "/location"
+)
+
+type LocationAPI struct {
+	ResourceAPI[Location, LocationRef]
+}
+
+func newLocationAPI(cfg Config) LocationAPI {
+	return LocationAPI{newResourceAPI[Location, LocationRef](cfg, apiLocationPath)}
+}
@@ -0,0 +1,14 @@
+package pokesdk
+
+type LocationRef = ResourceRef[Location]
+
+type LocationList = ResourceList[LocationRef]
+
+type Location struct {
+	ID          int                   `json:"id"`
+	Name        string                `json:"name"`
+	Region      *RegionRef            `json:"region"`
+	Names       LocalizedNames        `json:"names"`
+	GameIndices []GenerationGameIndex `json:"game_indices"`
+	Areas       []LocationAreaRef     `json:"areas"`
+}
@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiLocationAreaPath = "/location-area"
+)
+
+type LocationAreaAPI struct {
+	ResourceAPI[LocationArea, LocationAreaRef]
+}
+
+func newLocationAreaAPI(cfg Config) LocationAreaAPI {
+	return LocationAreaAPI{newResourceAPI[LocationArea, LocationAreaRef](cfg, apiLocationAreaPath)}
+}
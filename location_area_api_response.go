@@ -0,0 +1,36 @@
+package pokesdk
+
+type LocationAreaRef = ResourceRef[LocationArea]
+
+type LocationAreaList = ResourceList[LocationAreaRef]
+
+type LocationArea struct {
+	ID                   int                   `json:"id"`
+	Name                 string                `json:"name"`
+	GameIndex            int                   `json:"game_index"`
+	EncounterMethodRates []EncounterMethodRate `json:"encounter_method_rates"`
+	Location             LocationRef           `json:"location"`
+	Names                LocalizedNames        `json:"names"`
+	PokemonEncounters    []PokemonEncounter    `json:"pokemon_encounters"`
+}
+
+type EncounterMethodRate struct {
+	EncounterMethod EncounterMethodRef        `json:"encounter_method"`
+	VersionDetails  []EncounterVersionDetails `json:"version_details"`
+}
+
+type EncounterVersionDetails struct {
+	Rate    int        `json:"rate"`
+	Version VersionRef `json:"version"`
+}
+
+type PokemonEncounter struct {
+	Pokemon        PokemonRef               `json:"pokemon"`
+	VersionDetails []VersionEncounterDetail `json:"version_details"`
+}
+
+// LocationAreaEncounter describes where a Pokemon can be encountered, as returned by PokemonAPI.GetEncounters.
+type LocationAreaEncounter struct {
+	LocationArea   LocationAreaRef          `json:"location_area"`
+	VersionDetails []VersionEncounterDetail `json:"version_details"`
+}
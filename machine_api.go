@@ -0,0 +1,62 @@
+package pokesdk
+
+import "context"
+
+const (
+	apiMachinePath = "/machine"
+)
+
+type MachineAPI struct {
+	resources ResourceAPI[Machine, MachineRef]
+}
+
+func newMachineAPI(cfg Config) MachineAPI {
+	return MachineAPI{resources: newResourceAPI[Machine, MachineRef](cfg, apiMachinePath)}
+}
+
+// ErrNotFound returns the error that's returned when getting a machine that doesn't exist, which is
+// ErrMachineNotFound.
+func (m MachineAPI) ErrNotFound() error {
+	return m.resources.ErrNotFound()
+}
+
+// List returns a Paginator for listing all Machines.
+// It accepts no context argument because it should be provided to the paginator's functions instead.
+func (m MachineAPI) List() *ListPaginator[MachineRef] {
+	return m.resources.List()
+}
+
+// GetByID retrieves a specific Machine by its ID.
+func (m MachineAPI) GetByID(ctx context.Context, ID int, opts ...GetOption) (*Machine, error) {
+	return m.resources.GetByID(ctx, ID, opts...)
+}
+
+// GetByRef retrieves a specific Machine by its reference.
+// The reference is returned in the response from List()
+func (m MachineAPI) GetByRef(ctx context.Context, ref MachineRef, opts ...GetOption) (*Machine, error) {
+	return m.resources.GetByRef(ctx, ref, opts...)
+}
+
+// GetMany retrieves the machines with the given IDs in parallel. The results are in the same order as the IDs, and a
+// machine that can't be fetched has its error set in its Result without affecting the others.
+func (m MachineAPI) GetMany(ctx context.Context, IDs []int, opts ...GetOption) []Result[Machine] {
+	return m.resources.getManyByID(ctx, IDs, opts)
+}
+
+// GetManyByRef retrieves the machines with the given references in parallel, such as the results of a page from List().
+// It works the same way as GetMany.
+func (m MachineAPI) GetManyByRef(ctx context.Context, refs []MachineRef, opts ...GetOption) []Result[Machine] {
+	return m.resources.GetManyByRef(ctx, refs, opts...)
+}
+
+// GetByMove retrieves the Machine that teaches the given Move in the given version group. The item of the returned
+// machine is the TM, HM or TR disc itself (e.g. tm24).
+// It returns ErrMachineNotFound if the move can't be taught by a machine in the version group.
+func (m MachineAPI) GetByMove(ctx context.Context, move *Move, versionGroup string) (*Machine, error) {
+	ref, ok := move.MachineFor(versionGroup)
+	if !ok {
+		return nil, ErrMachineNotFound
+	}
+
+	return m.resources.get(ctx, ref.URL)
+}
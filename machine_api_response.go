@@ -0,0 +1,14 @@
+package pokesdk
+
+// MachineRef references a Machine.
+// Machines are unnamed, so only the URL is populated.
+type MachineRef = ResourceRef[Machine]
+
+type MachineList = ResourceList[MachineRef]
+
+type Machine struct {
+	ID           int             `json:"id"`
+	Item         ItemRef         `json:"item"`
+	Move         MoveRef         `json:"move"`
+	VersionGroup VersionGroupRef `json:"version_group"`
+}
@@ -0,0 +1,67 @@
+package pokesdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jameshalsall/pokesdk/pokesdktest"
+)
+
+func TestMachineAPI_GetByMove(t *testing.T) {
+	ctx := context.Background()
+
+	move := &Move{
+		Name: "thunderbolt",
+		Machines: []MachineVersionDetail{
+			{Machine: MachineRef{URL: "http://example.com/machine/24/"}, VersionGroup: VersionGroupRef{Name: "red-blue"}},
+			{Machine: MachineRef{URL: "http://example.com/machine/1416/"}, VersionGroup: VersionGroupRef{Name: "scarlet-violet"}},
+		},
+	}
+
+	t.Run("it should get the machine that teaches a move in a version group", func(t *testing.T) {
+		client, mocks := newMachineApiForTests(t)
+		mocks.backend.HydrateWith([]byte(`{
+			"id": 24,
+			"item": {"name": "tm24", "url": "https://pokeapi.co/api/v2/item/328/"},
+			"move": {"name": "thunderbolt", "url": "https://pokeapi.co/api/v2/move/85/"},
+			"version_group": {"name": "red-blue", "url": "https://pokeapi.co/api/v2/version-group/1/"}
+		}`))
+
+		mocks.backend.On("Process", ctx, "http://example.com/machine/24/", map[string]string(nil), mock.Anything).Return(nil).Once()
+
+		machine, err := client.GetByMove(ctx, move, "red-blue")
+
+		require.NoError(t, err)
+		assert.Equal(t, 24, machine.ID)
+		assert.Equal(t, "tm24", machine.Item.Name)
+		assert.Equal(t, "thunderbolt", machine.Move.Name)
+		assert.Equal(t, "red-blue", machine.VersionGroup.Name)
+	})
+
+	t.Run("it should return an error if the move is not taught by a machine in the version group", func(t *testing.T) {
+		client, _ := newMachineApiForTests(t)
+
+		_, err := client.GetByMove(ctx, move, "gold-silver")
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrMachineNotFound)
+	})
+}
+
+type machineApiMocks struct {
+	backend *pokesdktest.MockBackend
+}
+
+func newMachineApiForTests(t *testing.T) (MachineAPI, machineApiMocks) {
+	t.Helper()
+
+	mocks := machineApiMocks{
+		backend: &pokesdktest.MockBackend{},
+	}
+
+	return newMachineAPI(Config{baseURL: "http://example.com/", backend: mocks.backend}), mocks
+}
@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiMoveAilmentPath = "/move-ailment"
+)
+
+type MoveAilmentAPI struct {
+	ResourceAPI[MoveAilment, MoveAilmentRef]
+}
+
+func newMoveAilmentAPI(cfg Config) MoveAilmentAPI {
+	return MoveAilmentAPI{newResourceAPI[MoveAilment, MoveAilmentRef](cfg, apiMoveAilmentPath)}
+}
@@ -0,0 +1,12 @@
+package pokesdk
+
+type MoveAilmentRef = ResourceRef[MoveAilment]
+
+type MoveAilmentList = ResourceList[MoveAilmentRef]
+
+type MoveAilment struct {
+	ID    int            `json:"id"`
+	Name  string         `json:"name"`
+	Moves []MoveRef      `json:"moves"`
+	Names LocalizedNames `json:"names"`
+}
@@ -0,0 +1,56 @@
+package pokesdk
+
+import "context"
+
+const (
+	apiMovePath = "/move"
+)
+
+type MoveAPI struct {
+	ResourceAPI[Move, MoveRef]
+}
+
+func newMoveAPI(cfg Config) MoveAPI {
+	return MoveAPI{newResourceAPI[Move, MoveRef](cfg, apiMovePath)}
+}
+
+// GetContestCombos resolves the contest and super contest combos of the given Move into full Move values.
+// Moves that appear in more than one combo are only fetched once. A move without contest data has no combos.
+func (m MoveAPI) GetContestCombos(ctx context.Context, move *Move) (*ContestCombos, error) {
+	combos := &ContestCombos{}
+	if move.ContestCombos == nil {
+		return combos, nil
+	}
+
+	moves := make(map[string]*Move)
+	resolve := func(refs []MoveRef) ([]*Move, error) {
+		var resolved []*Move
+		for _, ref := range refs {
+			if _, ok := moves[ref.URL]; !ok {
+				mv, err := m.get(ctx, ref.URL)
+				if err != nil {
+					return nil, err
+				}
+				moves[ref.URL] = mv
+			}
+			resolved = append(resolved, moves[ref.URL])
+		}
+		return resolved, nil
+	}
+
+	var err error
+	if combos.Normal.UseBefore, err = resolve(move.ContestCombos.Normal.UseBefore); err != nil {
+		return nil, err
+	}
+	if combos.Normal.UseAfter, err = resolve(move.ContestCombos.Normal.UseAfter); err != nil {
+		return nil, err
+	}
+	if combos.Super.UseBefore, err = resolve(move.ContestCombos.Super.UseBefore); err != nil {
+		return nil, err
+	}
+	if combos.Super.UseAfter, err = resolve(move.ContestCombos.Super.UseAfter); err != nil {
+		return nil, err
+	}
+
+	return combos, nil
+}
@@ -0,0 +1,106 @@
+package pokesdk
+
+type MoveRef = ResourceRef[Move]
+
+type MoveList = ResourceList[MoveRef]
+
+type Move struct {
+	ID                 int                    `json:"id"`
+	Name               string                 `json:"name"`
+	Accuracy           *int                   `json:"accuracy"`
+	EffectChance       *int                   `json:"effect_chance"`
+	PP                 *int                   `json:"pp"`
+	Priority           int                    `json:"priority"`
+	Power              *int                   `json:"power"`
+	ContestCombos      *ContestComboSets      `json:"contest_combos"`
+	ContestType        *ContestTypeRef        `json:"contest_type"`
+	ContestEffect      *ContestEffectRef      `json:"contest_effect"`
+	DamageClass        MoveDamageClassRef     `json:"damage_class"`
+	EffectEntries      []VerboseEffect        `json:"effect_entries"`
+	EffectChanges      []AbilityEffectChange  `json:"effect_changes"`
+	LearnedByPokemon   []PokemonRef           `json:"learned_by_pokemon"`
+	FlavorTextEntries  []MoveFlavorText       `json:"flavor_text_entries"`
+	Generation         GenerationRef          `json:"generation"`
+	Machines           []MachineVersionDetail `json:"machines"`
+	Meta               *MoveMetaData          `json:"meta"`
+	Names              LocalizedNames         `json:"names"`
+	PastValues         []PastMoveStatValues   `json:"past_values"`
+	StatChanges        []MoveStatChange       `json:"stat_changes"`
+	SuperContestEffect *SuperContestEffectRef `json:"super_contest_effect"`
+	Target             MoveTargetRef          `json:"target"`
+	Type               TypeRef                `json:"type"`
+}
+
+type ContestComboSets struct {
+	Normal ContestComboDetail `json:"normal"`
+	Super  ContestComboDetail `json:"super"`
+}
+
+type ContestComboDetail struct {
+	UseBefore []MoveRef `json:"use_before"`
+	UseAfter  []MoveRef `json:"use_after"`
+}
+
+// ContestCombos holds the moves that form a combo with a Move in contests and super contests, resolved into full
+// Move values.
+type ContestCombos struct {
+	Normal ContestComboMoves
+	Super  ContestComboMoves
+}
+
+// ContestComboMoves holds the moves that, used before or after a Move, form a combo with it.
+type ContestComboMoves struct {
+	UseBefore []*Move
+	UseAfter  []*Move
+}
+
+type MoveFlavorText struct {
+	FlavorText   string          `json:"flavor_text"`
+	Language     LanguageRef     `json:"language"`
+	VersionGroup VersionGroupRef `json:"version_group"`
+}
+
+type MachineVersionDetail struct {
+	Machine      MachineRef      `json:"machine"`
+	VersionGroup VersionGroupRef `json:"version_group"`
+}
+
+type MoveMetaData struct {
+	Ailment       MoveAilmentRef  `json:"ailment"`
+	Category      MoveCategoryRef `json:"category"`
+	MinHits       *int            `json:"min_hits"`
+	MaxHits       *int            `json:"max_hits"`
+	MinTurns      *int            `json:"min_turns"`
+	MaxTurns      *int            `json:"max_turns"`
+	Drain         int             `json:"drain"`
+	Healing       int             `json:"healing"`
+	CritRate      int             `json:"crit_rate"`
+	AilmentChance int             `json:"ailment_chance"`
+	FlinchChance  int             `json:"flinch_chance"`
+	StatChance    int             `json:"stat_chance"`
+}
+
+type MoveStatChange struct {
+	Change int     `json:"change"`
+	Stat   StatRef `json:"stat"`
+}
+
+type PastMoveStatValues struct {
+	Accuracy      *int            `json:"accuracy"`
+	EffectChance  *int            `json:"effect_chance"`
+	Power         *int            `json:"power"`
+	PP            *int            `json:"pp"`
+	EffectEntries []VerboseEffect `json:"effect_entries"`
+	Type          *TypeRef        `json:"type"`
+	VersionGroup  VersionGroupRef `json:"version_group"`
+}
+
+// MachineFor returns the reference to the Machine (TM, HM or TR) that teaches the move in the given version group.
+func (m *Move) MachineFor(versionGroup string) (MachineRef, bool) {
+	for _, detail := range m.Machines {
+		if detail.VersionGroup.Name == versionGroup {
+			return detail.Machine, true
+		}
+	}
+	return MachineRef{}, false
+}
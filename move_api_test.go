@@ -0,0 +1,109 @@
+package pokesdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jameshalsall/pokesdk/internal/backend"
+	"github.com/jameshalsall/pokesdk/pokesdktest"
+)
+
+func TestMove_MachineFor(t *testing.T) {
+	move := &Move{
+		Name: "thunderbolt",
+		Machines: []MachineVersionDetail{
+			{Machine: MachineRef{URL: "https://pokeapi.co/api/v2/machine/24/"}, VersionGroup: VersionGroupRef{Name: "red-blue"}},
+		},
+	}
+
+	t.Run("it returns the machine for the version group", func(t *testing.T) {
+		ref, ok := move.MachineFor("red-blue")
+
+		assert.True(t, ok)
+		assert.Equal(t, "https://pokeapi.co/api/v2/machine/24/", ref.URL)
+	})
+
+	t.Run("it returns false if there is no machine in the version group", func(t *testing.T) {
+		_, ok := move.MachineFor("gold-silver")
+
+		assert.False(t, ok)
+	})
+}
+
+func TestMoveAPI_GetContestCombos(t *testing.T) {
+	ctx := context.Background()
+
+	move := &Move{
+		Name: "mean-look",
+		ContestCombos: &ContestComboSets{
+			Normal: ContestComboDetail{
+				UseBefore: []MoveRef{{Name: "destiny-bond", URL: "http://example.com/move/194/"}},
+				UseAfter:  []MoveRef{{Name: "spite", URL: "http://example.com/move/180/"}},
+			},
+			Super: ContestComboDetail{
+				UseBefore: []MoveRef{{Name: "destiny-bond", URL: "http://example.com/move/194/"}},
+			},
+		},
+	}
+
+	t.Run("it should resolve the contest combos of a move", func(t *testing.T) {
+		client, mocks := newMoveApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/move/194/", map[string]string(nil), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(3).(*Move) = Move{ID: 194, Name: "destiny-bond"}
+		}).Once()
+		mocks.backend.On("Process", ctx, "http://example.com/move/180/", map[string]string(nil), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(3).(*Move) = Move{ID: 180, Name: "spite"}
+		}).Once()
+
+		combos, err := client.GetContestCombos(ctx, move)
+
+		require.NoError(t, err)
+		require.Len(t, combos.Normal.UseBefore, 1)
+		assert.Equal(t, "destiny-bond", combos.Normal.UseBefore[0].Name)
+		require.Len(t, combos.Normal.UseAfter, 1)
+		assert.Equal(t, "spite", combos.Normal.UseAfter[0].Name)
+		require.Len(t, combos.Super.UseBefore, 1)
+		assert.Same(t, combos.Normal.UseBefore[0], combos.Super.UseBefore[0])
+		assert.Empty(t, combos.Super.UseAfter)
+		mocks.backend.AssertExpectations(t)
+	})
+
+	t.Run("it should return no combos for a move without contest data", func(t *testing.T) {
+		client, _ := newMoveApiForTests(t)
+
+		combos, err := client.GetContestCombos(ctx, &Move{Name: "hyperspace-fury"})
+
+		require.NoError(t, err)
+		assert.Equal(t, &ContestCombos{}, combos)
+	})
+
+	t.Run("it should return an error if a combo move does not exist", func(t *testing.T) {
+		client, mocks := newMoveApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/move/194/", map[string]string(nil), mock.Anything).Return(backend.ErrResourceNotFound).Once()
+
+		_, err := client.GetContestCombos(ctx, move)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrMoveNotFound)
+	})
+}
+
+type moveApiMocks struct {
+	backend *pokesdktest.MockBackend
+}
+
+func newMoveApiForTests(t *testing.T) (MoveAPI, moveApiMocks) {
+	t.Helper()
+
+	mocks := moveApiMocks{
+		backend: &pokesdktest.MockBackend{},
+	}
+
+	return newMoveAPI(Config{baseURL: "http://example.com/", backend: mocks.backend}), mocks
+}
@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiMoveBattleStylePath = "/move-battle-style"
+)
+
+type MoveBattleStyleAPI struct {
+	ResourceAPI[MoveBattleStyle, MoveBattleStyleRef]
+}
+
+func newMoveBattleStyleAPI(cfg Config) MoveBattleStyleAPI {
+	return MoveBattleStyleAPI{newResourceAPI[MoveBattleStyle, MoveBattleStyleRef](cfg, apiMoveBattleStylePath)}
+}
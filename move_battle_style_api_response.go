@@ -0,0 +1,11 @@
+package pokesdk
+
+type MoveBattleStyleRef = ResourceRef[MoveBattleStyle]
+
+type MoveBattleStyleList = ResourceList[MoveBattleStyleRef]
+
+type MoveBattleStyle struct {
+	ID    int            `json:"id"`
+	Name  string         `json:"name"`
+	Names LocalizedNames `json:"names"`
+}
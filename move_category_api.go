@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiMoveCategoryPath = "/move-category"
+)
+
+type MoveCategoryAPI struct {
+	ResourceAPI[MoveCategory, MoveCategoryRef]
+}
+
+func newMoveCategoryAPI(cfg Config) MoveCategoryAPI {
+	return MoveCategoryAPI{newResourceAPI[MoveCategory, MoveCategoryRef](cfg, apiMoveCategoryPath)}
+}
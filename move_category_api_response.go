@@ -0,0 +1,12 @@
+package pokesdk
+
+type MoveCategoryRef = ResourceRef[MoveCategory]
+
+type MoveCategoryList = ResourceList[MoveCategoryRef]
+
+type MoveCategory struct {
+	ID           int           `json:"id"`
+	Name         string        `json:"name"`
+	Moves        []MoveRef     `json:"moves"`
+	Descriptions []Description `json:"descriptions"`
+}
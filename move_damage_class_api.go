@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiMoveDamageClassPath = "/move-damage-class"
+)
+
+type MoveDamageClassAPI struct {
+	ResourceAPI[MoveDamageClass, MoveDamageClassRef]
+}
+
+func newMoveDamageClassAPI(cfg Config) MoveDamageClassAPI {
+	return MoveDamageClassAPI{newResourceAPI[MoveDamageClass, MoveDamageClassRef](cfg, apiMoveDamageClassPath)}
+}
@@ -0,0 +1,13 @@
+package pokesdk
+
+type MoveDamageClassRef = ResourceRef[MoveDamageClass]
+
+type MoveDamageClassList = ResourceList[MoveDamageClassRef]
+
+type MoveDamageClass struct {
+	ID           int            `json:"id"`
+	Name         string         `json:"name"`
+	Descriptions []Description  `json:"descriptions"`
+	Moves        []MoveRef      `json:"moves"`
+	Names        LocalizedNames `json:"names"`
+}
@@ -0,0 +1,34 @@
+package pokesdk
+
+import "context"
+
+const (
+	apiMoveLearnMethodPath = "/move-learn-method"
+)
+
+type MoveLearnMethodAPI struct {
+	ResourceAPI[MoveLearnMethod, MoveLearnMethodRef]
+}
+
+func newMoveLearnMethodAPI(cfg Config) MoveLearnMethodAPI {
+	return MoveLearnMethodAPI{newResourceAPI[MoveLearnMethod, MoveLearnMethodRef](cfg, apiMoveLearnMethodPath)}
+}
+
+// GetByLearnset retrieves every Move Learn Method used by the given learnset entries, e.g. from Pokemon.Learnset.
+// The returned map is keyed by learn method name, and each learn method is only fetched once.
+func (m MoveLearnMethodAPI) GetByLearnset(ctx context.Context, entries []LearnsetEntry) (map[string]*MoveLearnMethod, error) {
+	methods := make(map[string]*MoveLearnMethod)
+	for _, entry := range entries {
+		if _, ok := methods[entry.Method.Name]; ok {
+			continue
+		}
+
+		method, err := m.get(ctx, entry.Method.URL)
+		if err != nil {
+			return nil, err
+		}
+		methods[entry.Method.Name] = method
+	}
+
+	return methods, nil
+}
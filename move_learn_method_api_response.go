@@ -0,0 +1,13 @@
+package pokesdk
+
+type MoveLearnMethodRef = ResourceRef[MoveLearnMethod]
+
+type MoveLearnMethodList = ResourceList[MoveLearnMethodRef]
+
+type MoveLearnMethod struct {
+	ID            int               `json:"id"`
+	Name          string            `json:"name"`
+	Descriptions  []Description     `json:"descriptions"`
+	Names         LocalizedNames    `json:"names"`
+	VersionGroups []VersionGroupRef `json:"version_groups"`
+}
@@ -0,0 +1,64 @@
+package pokesdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jameshalsall/pokesdk/internal/backend"
+	"github.com/jameshalsall/pokesdk/pokesdktest"
+)
+
+func TestMoveLearnMethodAPI_GetByLearnset(t *testing.T) {
+	ctx := context.Background()
+
+	entries := []LearnsetEntry{
+		{Move: MoveRef{Name: "tackle"}, Method: MoveLearnMethodRef{Name: "level-up", URL: "http://example.com/move-learn-method/1/"}, Level: 1},
+		{Move: MoveRef{Name: "vine-whip"}, Method: MoveLearnMethodRef{Name: "level-up", URL: "http://example.com/move-learn-method/1/"}, Level: 3},
+		{Move: MoveRef{Name: "swords-dance"}, Method: MoveLearnMethodRef{Name: "machine", URL: "http://example.com/move-learn-method/4/"}},
+	}
+
+	t.Run("it should get each learn method used by the learnset once", func(t *testing.T) {
+		client, mocks := newMoveLearnMethodApiForTests(t)
+		mocks.backend.HydrateWith([]byte(`{"id": 1, "name": "level-up"}`))
+
+		mocks.backend.On("Process", ctx, "http://example.com/move-learn-method/1/", map[string]string(nil), mock.Anything).Return(nil).Once()
+		mocks.backend.On("Process", ctx, "http://example.com/move-learn-method/4/", map[string]string(nil), mock.Anything).Return(nil).Once()
+
+		methods, err := client.GetByLearnset(ctx, entries)
+
+		require.NoError(t, err)
+		assert.Len(t, methods, 2)
+		assert.Contains(t, methods, "level-up")
+		assert.Contains(t, methods, "machine")
+		mocks.backend.AssertExpectations(t)
+	})
+
+	t.Run("it should return an error if a learn method does not exist", func(t *testing.T) {
+		client, mocks := newMoveLearnMethodApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/move-learn-method/1/", map[string]string(nil), mock.Anything).Return(backend.ErrResourceNotFound).Once()
+
+		_, err := client.GetByLearnset(ctx, entries)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrMoveLearnMethodNotFound)
+	})
+}
+
+type moveLearnMethodApiMocks struct {
+	backend *pokesdktest.MockBackend
+}
+
+func newMoveLearnMethodApiForTests(t *testing.T) (MoveLearnMethodAPI, moveLearnMethodApiMocks) {
+	t.Helper()
+
+	mocks := moveLearnMethodApiMocks{
+		backend: &pokesdktest.MockBackend{},
+	}
+
+	return newMoveLearnMethodAPI(Config{baseURL: "http://example.com/", backend: mocks.backend}), mocks
+}
@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiMoveTargetPath = "/move-target"
+)
+
+type MoveTargetAPI struct {
+	ResourceAPI[MoveTarget, MoveTargetRef]
+}
+
+func newMoveTargetAPI(cfg Config) MoveTargetAPI {
+	return MoveTargetAPI{newResourceAPI[MoveTarget, MoveTargetRef](cfg, apiMoveTargetPath)}
+}
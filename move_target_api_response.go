@@ -0,0 +1,13 @@
+package pokesdk
+
+type MoveTargetRef = ResourceRef[MoveTarget]
+
+type MoveTargetList = ResourceList[MoveTargetRef]
+
+type MoveTarget struct {
+	ID           int            `json:"id"`
+	Name         string         `json:"name"`
+	Descriptions []Description  `json:"descriptions"`
+	Moves        []MoveRef      `json:"moves"`
+	Names        LocalizedNames `json:"names"`
+}
@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiNaturePath = "/nature"
+)
+
+type NatureAPI struct {
+	ResourceAPI[Nature, NatureRef]
+}
+
+func newNatureAPI(cfg Config) NatureAPI {
+	return NatureAPI{newResourceAPI[Nature, NatureRef](cfg, apiNaturePath)}
+}
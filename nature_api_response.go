@@ -0,0 +1,28 @@
+package pokesdk
+
+type NatureRef = ResourceRef[Nature]
+
+type NatureList = ResourceList[NatureRef]
+
+type Nature struct {
+	ID                         int                         `json:"id"`
+	Name                       string                      `json:"name"`
+	DecreasedStat              *StatRef                    `json:"decreased_stat"`
+	IncreasedStat              *StatRef                    `json:"increased_stat"`
+	HatesFlavor                *BerryFlavorRef             `json:"hates_flavor"`
+	LikesFlavor                *BerryFlavorRef             `json:"likes_flavor"`
+	PokeathlonStatChanges      []NatureStatChange          `json:"pokeathlon_stat_changes"`
+	MoveBattleStylePreferences []MoveBattleStylePreference `json:"move_battle_style_preferences"`
+	Names                      LocalizedNames              `json:"names"`
+}
+
+type NatureStatChange struct {
+	MaxChange      int               `json:"max_change"`
+	PokeathlonStat PokeathlonStatRef `json:"pokeathlon_stat"`
+}
+
+type MoveBattleStylePreference struct {
+	LowHPPreference  int                `json:"low_hp_preference"`
+	HighHPPreference int                `json:"high_hp_preference"`
+	MoveBattleStyle  MoveBattleStyleRef `json:"move_battle_style"`
+}
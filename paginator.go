@@ -2,6 +2,7 @@ package pokesdk
 
 import (
 	"context"
+	"iter"
 	"sync"
 )
 
@@ -36,29 +37,54 @@ func NewPaginator[T PageableResource](start string, fetch func(context.Context,
 }
 
 // All returns a channel that will yield all pages of results from the paginator.
-// The channel will be closed when all pages have been fetched or if the context is done.
+// The channel will be closed when all pages have been fetched, after a page with an error, or if the context is done.
+// A consumer that stops reading early must cancel the context, otherwise the goroutine sending the pages is leaked.
+//
+// Deprecated: Use Pages, or Items when listing resources, which fetch pages as the loop needs them and stop when it
+// breaks without leaking a goroutine.
 func (p *Paginator[T]) All(ctx context.Context) <-chan *Page[T] {
 	pages := make(chan *Page[T], 1)
 
 	go func() {
 		defer close(pages)
 
-		select {
-		case <-ctx.Done():
-			return
-		default:
+		for data, err := range p.Pages(ctx) {
+			if ctx.Err() != nil {
+				return
+			}
+
+			select {
+			case pages <- &Page[T]{Data: data, Error: err}:
+			case <-ctx.Done():
+				return
+			}
 		}
+	}()
+
+	return pages
+}
 
+// Pages returns an iterator over the remaining pages of results from the paginator, for use with a range loop.
+// Iteration stops after the first error, which is yielded along with a zero page. Pages are fetched as the loop
+// needs them, so breaking out of the loop stops fetching.
+func (p *Paginator[T]) Pages(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
 		for {
+			if err := ctx.Err(); err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
 			page := p.Next(ctx)
 			if page == nil {
 				return
 			}
-			pages <- page
+			if !yield(page.Data, page.Error) || page.Error != nil {
+				return
+			}
 		}
-	}()
-
-	return pages
+	}
 }
 
 // Next fetches the next page of results from the paginator.
@@ -81,3 +107,29 @@ func (p *Paginator[T]) Next(ctx context.Context) *Page[T] {
 
 	return &Page[T]{Data: page}
 }
+
+// ListPaginator is a Paginator over the pages returned when listing resources, where R is the type of the references
+// on each page.
+type ListPaginator[R Reference] struct {
+	*Paginator[*ResourceList[R]]
+}
+
+// Items returns an iterator over the references on the remaining pages, fetching each page as the loop reaches it.
+// Iteration stops after the first error, which is yielded along with a zero reference.
+func (l *ListPaginator[R]) Items(ctx context.Context) iter.Seq2[R, error] {
+	return func(yield func(R, error) bool) {
+		for page, err := range l.Pages(ctx) {
+			if err != nil {
+				var zero R
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range page.Results {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
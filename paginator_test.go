@@ -3,6 +3,7 @@ package pokesdk
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -107,4 +108,190 @@ func TestPaginator_All(t *testing.T) {
 			t.Fatal("timeout waiting for pages channel to close")
 		}
 	})
+
+	t.Run("it stops after a page with an error", func(t *testing.T) {
+		fetch := func(ctx context.Context, url string) (*mockPage, error) {
+			return nil, errors.New("fetch failed")
+		}
+
+		p := NewPaginator[*mockPage]("start", fetch)
+
+		var pages []*Page[*mockPage]
+		for page := range p.All(context.Background()) {
+			pages = append(pages, page)
+		}
+
+		require.Len(t, pages, 1)
+		assert.EqualError(t, pages[0].Error, "fetch failed")
+	})
+
+	t.Run("it stops fetching when the context is cancelled after the consumer stops reading", func(t *testing.T) {
+		var fetchCount atomic.Int32
+		fetch := func(ctx context.Context, url string) (*mockPage, error) {
+			fetchCount.Add(1)
+			return &mockPage{id: 1, next: "next"}, nil
+		}
+
+		p := NewPaginator[*mockPage]("start", fetch)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := p.All(ctx)
+		<-ch
+		cancel()
+
+		select {
+		case <-drain(ch):
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("timeout waiting for pages channel to close")
+		}
+		assert.LessOrEqual(t, fetchCount.Load(), int32(3))
+	})
+}
+
+func TestPaginator_Pages(t *testing.T) {
+	pagesData := map[string]*mockPage{
+		"start": {id: 1, next: "url2"},
+		"url2":  {id: 2, next: "url3"},
+		"url3":  {id: 3, next: ""},
+	}
+
+	t.Run("it iterates over every page", func(t *testing.T) {
+		p := NewPaginator[*mockPage]("start", func(ctx context.Context, url string) (*mockPage, error) {
+			return pagesData[url], nil
+		})
+
+		var ids []int
+		for page, err := range p.Pages(context.Background()) {
+			require.NoError(t, err)
+			ids = append(ids, page.id)
+		}
+
+		assert.Equal(t, []int{1, 2, 3}, ids)
+	})
+
+	t.Run("it stops fetching when the loop breaks", func(t *testing.T) {
+		var fetched []string
+		p := NewPaginator[*mockPage]("start", func(ctx context.Context, url string) (*mockPage, error) {
+			fetched = append(fetched, url)
+			return pagesData[url], nil
+		})
+
+		for range p.Pages(context.Background()) {
+			break
+		}
+
+		assert.Equal(t, []string{"start"}, fetched)
+
+		page := p.Next(context.Background())
+		require.NotNil(t, page)
+		assert.Equal(t, 2, page.Data.id)
+	})
+
+	t.Run("it stops after an error", func(t *testing.T) {
+		var fetchCount int
+		p := NewPaginator[*mockPage]("start", func(ctx context.Context, url string) (*mockPage, error) {
+			fetchCount++
+			return nil, errors.New("fetch failed")
+		})
+
+		var errs []error
+		for page, err := range p.Pages(context.Background()) {
+			assert.Nil(t, page)
+			errs = append(errs, err)
+		}
+
+		require.Len(t, errs, 1)
+		assert.EqualError(t, errs[0], "fetch failed")
+		assert.Equal(t, 1, fetchCount)
+	})
+
+	t.Run("it yields the error of a cancelled context without fetching", func(t *testing.T) {
+		p := NewPaginator[*mockPage]("start", func(ctx context.Context, url string) (*mockPage, error) {
+			t.Fatal("unexpected fetch")
+			return nil, nil
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		var errs []error
+		for _, err := range p.Pages(ctx) {
+			errs = append(errs, err)
+		}
+
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], context.Canceled)
+	})
+}
+
+func TestListPaginator_Items(t *testing.T) {
+	next := "page2"
+	pagesData := map[string]*ResourceList[PokemonRef]{
+		"start": {Next: &next, Results: []PokemonRef{{Name: "bulbasaur"}, {Name: "ivysaur"}}},
+		"page2": {Results: []PokemonRef{{Name: "venusaur"}}},
+	}
+
+	t.Run("it iterates over the references on every page", func(t *testing.T) {
+		l := &ListPaginator[PokemonRef]{NewPaginator[*ResourceList[PokemonRef]]("start", func(ctx context.Context, url string) (*ResourceList[PokemonRef], error) {
+			return pagesData[url], nil
+		})}
+
+		var names []string
+		for ref, err := range l.Items(context.Background()) {
+			require.NoError(t, err)
+			names = append(names, ref.Name)
+		}
+
+		assert.Equal(t, []string{"bulbasaur", "ivysaur", "venusaur"}, names)
+	})
+
+	t.Run("it only fetches the pages the loop reaches", func(t *testing.T) {
+		var fetched []string
+		l := &ListPaginator[PokemonRef]{NewPaginator[*ResourceList[PokemonRef]]("start", func(ctx context.Context, url string) (*ResourceList[PokemonRef], error) {
+			fetched = append(fetched, url)
+			return pagesData[url], nil
+		})}
+
+		for ref := range l.Items(context.Background()) {
+			if ref.Name == "ivysaur" {
+				break
+			}
+		}
+
+		assert.Equal(t, []string{"start"}, fetched)
+	})
+
+	t.Run("it yields the error of a page and stops", func(t *testing.T) {
+		l := &ListPaginator[PokemonRef]{NewPaginator[*ResourceList[PokemonRef]]("start", func(ctx context.Context, url string) (*ResourceList[PokemonRef], error) {
+			if url == "page2" {
+				return nil, errors.New("fetch failed")
+			}
+			return pagesData[url], nil
+		})}
+
+		var names []string
+		var errs []error
+		for ref, err := range l.Items(context.Background()) {
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			names = append(names, ref.Name)
+		}
+
+		assert.Equal(t, []string{"bulbasaur", "ivysaur"}, names)
+		require.Len(t, errs, 1)
+		assert.EqualError(t, errs[0], "fetch failed")
+	})
+}
+
+// drain reads the channel until it's closed, and returns a channel that's closed once it is.
+func drain[T any](ch <-chan T) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range ch {
+		}
+	}()
+	return done
 }
@@ -0,0 +1,29 @@
+package pokesdk
+
+import "context"
+
+const (
+	apiPalParkAreaPath = "/pal-park-area"
+)
+
+type PalParkAreaAPI struct {
+	ResourceAPI[PalParkArea, PalParkAreaRef]
+}
+
+func newPalParkAreaAPI(cfg Config) PalParkAreaAPI {
+	return PalParkAreaAPI{newResourceAPI[PalParkArea, PalParkAreaRef](cfg, apiPalParkAreaPath)}
+}
+
+// GetBySpecies retrieves every Pal Park Area the given species can be encountered in.
+func (p PalParkAreaAPI) GetBySpecies(ctx context.Context, species *PokemonSpecies) ([]*PalParkArea, error) {
+	areas := make([]*PalParkArea, 0, len(species.PalParkEncounters))
+	for _, encounter := range species.PalParkEncounters {
+		area, err := p.get(ctx, encounter.Area.URL)
+		if err != nil {
+			return nil, err
+		}
+		areas = append(areas, area)
+	}
+
+	return areas, nil
+}
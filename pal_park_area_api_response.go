@@ -0,0 +1,27 @@
+package pokesdk
+
+type PalParkAreaRef = ResourceRef[PalParkArea]
+
+type PalParkAreaList = ResourceList[PalParkAreaRef]
+
+type PalParkArea struct {
+	ID                int                       `json:"id"`
+	Name              string                    `json:"name"`
+	Names             LocalizedNames            `json:"names"`
+	PokemonEncounters []PalParkEncounterSpecies `json:"pokemon_encounters"`
+}
+
+type PalParkEncounterSpecies struct {
+	BaseScore      int        `json:"base_score"`
+	Rate           int        `json:"rate"`
+	PokemonSpecies SpeciesRef `json:"pokemon_species"`
+}
+
+// Species returns the species that can be encountered in the area.
+func (p *PalParkArea) Species() []SpeciesRef {
+	species := make([]SpeciesRef, 0, len(p.PokemonEncounters))
+	for _, encounter := range p.PokemonEncounters {
+		species = append(species, encounter.PokemonSpecies)
+	}
+	return species
+}
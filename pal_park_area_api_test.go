@@ -0,0 +1,63 @@
+package pokesdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jameshalsall/pokesdk/internal/backend"
+	"github.com/jameshalsall/pokesdk/pokesdktest"
+)
+
+func TestPalParkAreaAPI_GetBySpecies(t *testing.T) {
+	ctx := context.Background()
+
+	species := &PokemonSpecies{
+		Name: "bulbasaur",
+		PalParkEncounters: []PalParkEncounterArea{
+			{BaseScore: 50, Rate: 30, Area: PalParkAreaRef{Name: "field", URL: "http://example.com/pal-park-area/2/"}},
+		},
+	}
+
+	t.Run("it should get the pal park areas of a species", func(t *testing.T) {
+		client, mocks := newPalParkAreaApiForTests(t)
+		mocks.backend.HydrateWith([]byte(`{"id": 2, "name": "field", "pokemon_encounters": [{"base_score": 50, "rate": 30, "pokemon_species": {"name": "bulbasaur", "url": "http://example.com/pokemon-species/1/"}}]}`))
+
+		mocks.backend.On("Process", ctx, "http://example.com/pal-park-area/2/", map[string]string(nil), mock.Anything).Return(nil).Once()
+
+		areas, err := client.GetBySpecies(ctx, species)
+
+		require.NoError(t, err)
+		require.Len(t, areas, 1)
+		assert.Equal(t, "field", areas[0].Name)
+		assert.Equal(t, []SpeciesRef{{Name: "bulbasaur", URL: "http://example.com/pokemon-species/1/"}}, areas[0].Species())
+	})
+
+	t.Run("it should return an error if an area does not exist", func(t *testing.T) {
+		client, mocks := newPalParkAreaApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/pal-park-area/2/", map[string]string(nil), mock.Anything).Return(backend.ErrResourceNotFound).Once()
+
+		_, err := client.GetBySpecies(ctx, species)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrPalParkAreaNotFound)
+	})
+}
+
+type palParkAreaApiMocks struct {
+	backend *pokesdktest.MockBackend
+}
+
+func newPalParkAreaApiForTests(t *testing.T) (PalParkAreaAPI, palParkAreaApiMocks) {
+	t.Helper()
+
+	mocks := palParkAreaApiMocks{
+		backend: &pokesdktest.MockBackend{},
+	}
+
+	return newPalParkAreaAPI(Config{baseURL: "http://example.com/", backend: mocks.backend}), mocks
+}
@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiPokeathlonStatPath = "/pokeathlon-stat"
+)
+
+type PokeathlonStatAPI struct {
+	ResourceAPI[PokeathlonStat, PokeathlonStatRef]
+}
+
+func newPokeathlonStatAPI(cfg Config) PokeathlonStatAPI {
+	return PokeathlonStatAPI{newResourceAPI[PokeathlonStat, PokeathlonStatRef](cfg, apiPokeathlonStatPath)}
+}
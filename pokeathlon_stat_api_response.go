@@ -0,0 +1,22 @@
+package pokesdk
+
+type PokeathlonStatRef = ResourceRef[PokeathlonStat]
+
+type PokeathlonStatList = ResourceList[PokeathlonStatRef]
+
+type PokeathlonStat struct {
+	ID               int                            `json:"id"`
+	Name             string                         `json:"name"`
+	Names            LocalizedNames                 `json:"names"`
+	AffectingNatures NaturePokeathlonStatAffectSets `json:"affecting_natures"`
+}
+
+type NaturePokeathlonStatAffectSets struct {
+	Increase []NaturePokeathlonStatAffect `json:"increase"`
+	Decrease []NaturePokeathlonStatAffect `json:"decrease"`
+}
+
+type NaturePokeathlonStatAffect struct {
+	MaxChange int       `json:"max_change"`
+	Nature    NatureRef `json:"nature"`
+}
@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiPokedexPath = "/pokedex"
+)
+
+type PokedexAPI struct {
+	ResourceAPI[Pokedex, PokedexRef]
+}
+
+func newPokedexAPI(cfg Config) PokedexAPI {
+	return PokedexAPI{newResourceAPI[Pokedex, PokedexRef](cfg, apiPokedexPath)}
+}
@@ -0,0 +1,31 @@
+package pokesdk
+
+type PokedexRef = ResourceRef[Pokedex]
+
+type PokedexList = ResourceList[PokedexRef]
+
+type Pokedex struct {
+	ID             int               `json:"id"`
+	Name           string            `json:"name"`
+	IsMainSeries   bool              `json:"is_main_series"`
+	Descriptions   []Description     `json:"descriptions"`
+	Names          LocalizedNames    `json:"names"`
+	PokemonEntries []PokemonEntry    `json:"pokemon_entries"`
+	Region         *RegionRef        `json:"region"`
+	VersionGroups  []VersionGroupRef `json:"version_groups"`
+}
+
+type PokemonEntry struct {
+	EntryNumber    int        `json:"entry_number"`
+	PokemonSpecies SpeciesRef `json:"pokemon_species"`
+}
+
+// EntryNumber returns the regional dex number of the given Pokemon in the Pokedex, based on the Pokemon's species.
+func (p *Pokedex) EntryNumber(pokemon *Pokemon) (int, bool) {
+	for _, entry := range p.PokemonEntries {
+		if entry.PokemonSpecies.Name == pokemon.Species.Name {
+			return entry.EntryNumber, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,53 @@
+package pokesdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jameshalsall/pokesdk/pokesdktest"
+)
+
+func TestPokedex_EntryNumber(t *testing.T) {
+	pokedex := &Pokedex{
+		Name: "original-johto",
+		PokemonEntries: []PokemonEntry{
+			{EntryNumber: 1, PokemonSpecies: SpeciesRef{Name: "chikorita"}},
+			{EntryNumber: 22, PokemonSpecies: SpeciesRef{Name: "pikachu"}},
+		},
+	}
+
+	t.Run("it returns the regional dex number of a pokemon", func(t *testing.T) {
+		number, ok := pokedex.EntryNumber(&Pokemon{Name: "pikachu", Species: SpeciesRef{Name: "pikachu"}})
+
+		assert.True(t, ok)
+		assert.Equal(t, 22, number)
+	})
+
+	t.Run("it uses the species so alternate forms are found", func(t *testing.T) {
+		number, ok := pokedex.EntryNumber(&Pokemon{Name: "pikachu-original-cap", Species: SpeciesRef{Name: "pikachu"}})
+
+		assert.True(t, ok)
+		assert.Equal(t, 22, number)
+	})
+
+	t.Run("it returns false if the pokemon is not in the pokedex", func(t *testing.T) {
+		_, ok := pokedex.EntryNumber(&Pokemon{Name: "bulbasaur", Species: SpeciesRef{Name: "bulbasaur"}})
+
+		assert.False(t, ok)
+	})
+}
+
+type pokedexApiMocks struct {
+	backend *pokesdktest.MockBackend
+}
+
+func newPokedexApiForTests(t *testing.T) (PokedexAPI, pokedexApiMocks) {
+	t.Helper()
+
+	mocks := pokedexApiMocks{
+		backend: &pokesdktest.MockBackend{},
+	}
+
+	return newPokedexAPI(Config{baseURL: "http://example.com/", backend: mocks.backend}), mocks
+}
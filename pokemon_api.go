@@ -4,64 +4,66 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strconv"
 
 	"github.com/jameshalsall/pokesdk/internal/backend"
-	"github.com/jameshalsall/pokesdk/internal/urlutil"
 )
 
 const (
 	apiPokemonPath = "/pokemon"
 )
 
+// ErrNoDefaultVariety is returned by GetDefaultVariety when none of the varieties of a species is the default.
+var ErrNoDefaultVariety = errors.New("pokesdk: species has no default variety")
+
 type PokemonAPI struct {
-	cfg Config
+	ResourceAPI[Pokemon, PokemonRef]
 }
 
-// List returns a Paginator for listing all Pokemon.
-// It accepts no context argument because it should be provided to the paginator's functions instead.
-func (g PokemonAPI) List() *Paginator[*PokemonList] {
-	response := &PokemonList{}
+func newPokemonAPI(cfg Config) PokemonAPI {
+	return PokemonAPI{newResourceAPI[Pokemon, PokemonRef](cfg, apiPokemonPath)}
+}
 
-	return NewPaginator[*PokemonList](g.url(apiPokemonPath), func(ctx context.Context, nextUrl string) (*PokemonList, error) {
-		err := g.cfg.backend.Process(ctx, nextUrl, nil, response)
+// GetVarieties retrieves every Pokemon that is a variety of the given species (e.g. regional and mega forms), in the
+// order they're listed on the species.
+func (g PokemonAPI) GetVarieties(ctx context.Context, species *PokemonSpecies) ([]*Pokemon, error) {
+	varieties := make([]*Pokemon, 0, len(species.Varieties))
+	for _, variety := range species.Varieties {
+		pokemon, err := g.get(ctx, variety.Pokemon.URL)
 		if err != nil {
-			return nil, fmt.Errorf("pokesdk: error listing pokemon: %w", err)
+			return nil, err
 		}
+		varieties = append(varieties, pokemon)
+	}
 
-		return response, nil
-	})
+	return varieties, nil
 }
 
-// GetByName retrieves a specific Pokemon by its name.
-func (g PokemonAPI) GetByName(ctx context.Context, name string) (*Pokemon, error) {
-	return g.getPokemon(ctx, g.url(apiPokemonPath+"/"+name))
-}
+// GetDefaultVariety retrieves the default Pokemon of the given species.
+// It returns an error wrapping ErrNoDefaultVariety if the species doesn't have one.
+func (g PokemonAPI) GetDefaultVariety(ctx context.Context, species *PokemonSpecies) (*Pokemon, error) {
+	ref, ok := species.DefaultVariety()
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrNoDefaultVariety, species.Name)
+	}
 
-// GetByID retrieves a specific Pokemon by its ID.
-func (g PokemonAPI) GetByID(ctx context.Context, ID int) (*Pokemon, error) {
-	return g.getPokemon(ctx, g.url(apiPokemonPath+"/"+strconv.Itoa(ID)))
+	return g.get(ctx, ref.URL)
 }
 
-// GetByRef retrieves a specific Pokemon by its reference.
-// The reference is returned in the response from List()
-func (g PokemonAPI) GetByRef(ctx context.Context, ref PokemonRef) (*Pokemon, error) {
-	return g.getPokemon(ctx, ref.URL)
-}
+// GetEncounters retrieves the location areas where the given Pokemon can be encountered, by following its
+// LocationAreaEncounters URL. It returns an error if the Pokemon has no LocationAreaEncounters URL.
+func (g PokemonAPI) GetEncounters(ctx context.Context, pokemon *Pokemon) ([]LocationAreaEncounter, error) {
+	if pokemon.LocationAreaEncounters == "" {
+		return nil, fmt.Errorf("pokesdk: pokemon %q has no location area encounters URL", pokemon.Name)
+	}
 
-func (g PokemonAPI) getPokemon(ctx context.Context, url string) (*Pokemon, error) {
-	response := &Pokemon{}
-	err := g.cfg.backend.Process(ctx, url, nil, response)
+	var response []LocationAreaEncounter
+	err := g.cfg.backend.Process(ctx, pokemon.LocationAreaEncounters, nil, &response)
 	if err != nil {
 		if errors.Is(err, backend.ErrResourceNotFound) {
 			return nil, ErrPokemonNotFound
 		}
-		return nil, fmt.Errorf("pokesdk: error getting pokemon: %w", err)
+		return nil, fmt.Errorf("pokesdk: error getting pokemon encounters: %w", err)
 	}
 
 	return response, nil
 }
-
-func (g PokemonAPI) url(path string) string {
-	return urlutil.BuildURL(g.cfg.baseURL, path)
-}
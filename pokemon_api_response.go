@@ -1,48 +1,36 @@
 package pokesdk
 
-type PokemonRef NamedAPIResource
+type PokemonRef = ResourceRef[Pokemon]
 
-type PokemonList struct {
-	Count    int          `json:"count"`
-	Next     *string      `json:"next"`
-	Previous *string      `json:"previous"`
-	Results  []PokemonRef `json:"results"`
-}
-
-func (p *PokemonList) GetNextURL() string {
-	if p.Next == nil {
-		return ""
-	}
-	return *p.Next
-}
+type PokemonList = ResourceList[PokemonRef]
 
 type Pokemon struct {
-	ID                     int                `json:"id"`
-	Name                   string             `json:"name"`
-	Abilities              []PokemonAbility   `json:"abilities"`
-	BaseExperience         int                `json:"base_experience"`
-	Cries                  PokemonCries       `json:"cries"`
-	Forms                  []NamedAPIResource `json:"forms"`
-	GameIndices            []GameIndex        `json:"game_indices"`
-	Height                 int                `json:"height"`
-	HeldItems              []HeldItems        `json:"held_items"`
-	IsDefault              bool               `json:"is_default"`
-	LocationAreaEncounters string             `json:"location_area_encounters"`
-	Moves                  []PokemonMove      `json:"moves"`
-	Order                  int                `json:"order"`
-	PastAbilities          []PastAbilities    `json:"past_abilities"`
-	PastTypes              []PastTypes        `json:"past_types"`
-	Species                NamedAPIResource   `json:"species"`
-	Sprites                PokemonSprites     `json:"sprites"`
-	Stats                  []PokemonStat      `json:"stats"`
-	Types                  []PokemonType      `json:"types"`
-	Weight                 int                `json:"weight"`
+	ID                     int              `json:"id"`
+	Name                   string           `json:"name"`
+	Abilities              []PokemonAbility `json:"abilities"`
+	BaseExperience         int              `json:"base_experience"`
+	Cries                  PokemonCries     `json:"cries"`
+	Forms                  []PokemonFormRef `json:"forms"`
+	GameIndices            []GameIndex      `json:"game_indices"`
+	Height                 int              `json:"height"`
+	HeldItems              []HeldItems      `json:"held_items"`
+	IsDefault              bool             `json:"is_default"`
+	LocationAreaEncounters string           `json:"location_area_encounters"`
+	Moves                  []PokemonMove    `json:"moves"`
+	Order                  int              `json:"order"`
+	PastAbilities          []PastAbilities  `json:"past_abilities"`
+	PastTypes              []PastTypes      `json:"past_types"`
+	Species                SpeciesRef       `json:"species"`
+	Sprites                PokemonSprites   `json:"sprites"`
+	Stats                  []PokemonStat    `json:"stats"`
+	Types                  []PokemonType    `json:"types"`
+	Weight                 int              `json:"weight"`
 }
 
 type PokemonAbility struct {
-	Ability  NamedAPIResource `json:"ability"`
-	IsHidden bool             `json:"is_hidden"`
-	Slot     int              `json:"slot"`
+	Ability  AbilityRef `json:"ability"`
+	IsHidden bool       `json:"is_hidden"`
+	Slot     int        `json:"slot"`
 }
 
 type PokemonCries struct {
@@ -51,40 +39,40 @@ type PokemonCries struct {
 }
 
 type GameIndex struct {
-	GameIndex int              `json:"game_index"`
-	Version   NamedAPIResource `json:"version"`
+	GameIndex int        `json:"game_index"`
+	Version   VersionRef `json:"version"`
 }
 
 type PastTypes struct {
-	Generation NamedAPIResource `json:"generation"`
-	Types      []Types          `json:"types"`
+	Generation GenerationRef `json:"generation"`
+	Types      []Types       `json:"types"`
 }
 
 type Types struct {
-	Slot int              `json:"slot"`
-	Type NamedAPIResource `json:"type"`
+	Slot int     `json:"slot"`
+	Type TypeRef `json:"type"`
 }
 
 type HeldItems struct {
-	Item           NamedAPIResource `json:"item"`
+	Item           ItemRef          `json:"item"`
 	VersionDetails []VersionDetails `json:"version_details"`
 }
 
 type PokemonMove struct {
-	Move                NamedAPIResource         `json:"move"`
+	Move                MoveRef                  `json:"move"`
 	VersionGroupDetails []MoveVersionGroupDetail `json:"version_group_details"`
 }
 
 type MoveVersionGroupDetail struct {
-	LevelLearnedAt  int              `json:"level_learned_at"`
-	MoveLearnMethod NamedAPIResource `json:"move_learn_method"`
-	Order           *int             `json:"order"`
-	VersionGroup    NamedAPIResource `json:"version_group"`
+	LevelLearnedAt  int                `json:"level_learned_at"`
+	MoveLearnMethod MoveLearnMethodRef `json:"move_learn_method"`
+	Order           *int               `json:"order"`
+	VersionGroup    VersionGroupRef    `json:"version_group"`
 }
 
 type PastAbilities struct {
 	Abilities  []PokemonAbility `json:"abilities"`
-	Generation NamedAPIResource `json:"generation"`
+	Generation GenerationRef    `json:"generation"`
 }
 
 type PokemonSprites struct {
@@ -105,17 +93,17 @@ type Others map[string]map[string]string
 type Versions map[string]map[string]map[string]any
 
 type PokemonStat struct {
-	BaseStat int              `json:"base_stat"`
-	Effort   int              `json:"effort"`
-	Stat     NamedAPIResource `json:"stat"`
+	BaseStat int     `json:"base_stat"`
+	Effort   int     `json:"effort"`
+	Stat     StatRef `json:"stat"`
 }
 
 type PokemonType struct {
-	Slot int              `json:"slot"`
-	Type NamedAPIResource `json:"type"`
+	Slot int     `json:"slot"`
+	Type TypeRef `json:"type"`
 }
 
 type VersionDetails struct {
-	Rarity  int              `json:"rarity"`
-	Version NamedAPIResource `json:"version"`
+	Rarity  int        `json:"rarity"`
+	Version VersionRef `json:"version"`
 }
@@ -212,6 +212,149 @@ func TestPokemonAPI_GetByRef(t *testing.T) {
 	})
 }
 
+func TestPokemonAPI_GetVarieties(t *testing.T) {
+	ctx := context.Background()
+
+	species := &PokemonSpecies{
+		Name: "venusaur",
+		Varieties: []PokemonSpeciesVariety{
+			{IsDefault: true, Pokemon: PokemonRef{Name: "venusaur", URL: "http://example.com/pokemon/3/"}},
+			{IsDefault: false, Pokemon: PokemonRef{Name: "venusaur-mega", URL: "http://example.com/pokemon/10033/"}},
+		},
+	}
+
+	t.Run("it should get every variety of a species in order", func(t *testing.T) {
+		client, mocks := newPokemonApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon/3/", map[string]string(nil), mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(3).(*Pokemon) = Pokemon{ID: 3, Name: "venusaur", IsDefault: true}
+		}).Return(nil).Once()
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon/10033/", map[string]string(nil), mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(3).(*Pokemon) = Pokemon{ID: 10033, Name: "venusaur-mega"}
+		}).Return(nil).Once()
+
+		varieties, err := client.GetVarieties(ctx, species)
+
+		require.NoError(t, err)
+		require.Len(t, varieties, 2)
+		assert.Equal(t, "venusaur", varieties[0].Name)
+		assert.Equal(t, "venusaur-mega", varieties[1].Name)
+	})
+
+	t.Run("it should return an error if a variety does not exist", func(t *testing.T) {
+		client, mocks := newPokemonApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon/3/", map[string]string(nil), mock.Anything).Return(backend.ErrResourceNotFound).Once()
+
+		_, err := client.GetVarieties(ctx, species)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrPokemonNotFound)
+	})
+}
+
+func TestPokemonAPI_GetDefaultVariety(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("it should get the default variety of a species", func(t *testing.T) {
+		client, mocks := newPokemonApiForTests(t)
+		mocks.backend.HydrateWith([]byte(`{"id": 3, "name": "venusaur", "is_default": true}`))
+
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon/3/", map[string]string(nil), mock.Anything).Return(nil).Once()
+
+		species := &PokemonSpecies{
+			Varieties: []PokemonSpeciesVariety{
+				{IsDefault: false, Pokemon: PokemonRef{Name: "venusaur-mega", URL: "http://example.com/pokemon/10033/"}},
+				{IsDefault: true, Pokemon: PokemonRef{Name: "venusaur", URL: "http://example.com/pokemon/3/"}},
+			},
+		}
+		pokemon, err := client.GetDefaultVariety(ctx, species)
+
+		require.NoError(t, err)
+		assert.Equal(t, "venusaur", pokemon.Name)
+		assert.True(t, pokemon.IsDefault)
+	})
+
+	t.Run("it should return an error if the species has no default variety", func(t *testing.T) {
+		client, _ := newPokemonApiForTests(t)
+
+		_, err := client.GetDefaultVariety(ctx, &PokemonSpecies{Name: "missingno"})
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrNoDefaultVariety)
+		assert.NotErrorIs(t, err, ErrNotFound)
+		assert.EqualError(t, err, `pokesdk: species has no default variety: "missingno"`)
+	})
+}
+
+func TestPokemonAPI_GetEncounters(t *testing.T) {
+	ctx := context.Background()
+
+	pokemon := &Pokemon{ID: 1, Name: "bulbasaur", LocationAreaEncounters: "http://example.com/pokemon/1/encounters"}
+
+	t.Run("it should get the encounters of a pokemon", func(t *testing.T) {
+		client, mocks := newPokemonApiForTests(t)
+		mocks.backend.HydrateWith([]byte(`[{
+			"location_area": {"name": "cerulean-city-area", "url": "https://pokeapi.co/api/v2/location-area/281/"},
+			"version_details": [{
+				"version": {"name": "yellow", "url": "https://pokeapi.co/api/v2/version/3/"},
+				"max_chance": 100,
+				"encounter_details": [{
+					"min_level": 10,
+					"max_level": 10,
+					"chance": 100,
+					"method": {"name": "gift", "url": "https://pokeapi.co/api/v2/encounter-method/18/"},
+					"condition_values": []
+				}]
+			}]
+		}]`))
+
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon/1/encounters", map[string]string(nil), mock.Anything).Return(nil).Once()
+
+		encounters, err := client.GetEncounters(ctx, pokemon)
+
+		require.NoError(t, err)
+		require.Len(t, encounters, 1)
+		assert.Equal(t, "cerulean-city-area", encounters[0].LocationArea.Name)
+		require.Len(t, encounters[0].VersionDetails, 1)
+		assert.Equal(t, "yellow", encounters[0].VersionDetails[0].Version.Name)
+		assert.Equal(t, 100, encounters[0].VersionDetails[0].MaxChance)
+		require.Len(t, encounters[0].VersionDetails[0].EncounterDetails, 1)
+		assert.Equal(t, "gift", encounters[0].VersionDetails[0].EncounterDetails[0].Method.Name)
+		assert.Equal(t, 10, encounters[0].VersionDetails[0].EncounterDetails[0].MinLevel)
+	})
+
+	t.Run("it should return an error if getting encounters fails", func(t *testing.T) {
+		client, mocks := newPokemonApiForTests(t)
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon/1/encounters", map[string]string(nil), mock.Anything).Return(assert.AnError).Once()
+
+		_, err := client.GetEncounters(ctx, pokemon)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "pokesdk: error getting pokemon encounters")
+	})
+
+	t.Run("it should return an error if the pokemon does not exist", func(t *testing.T) {
+		client, mocks := newPokemonApiForTests(t)
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon/1/encounters", map[string]string(nil), mock.Anything).Return(backend.ErrResourceNotFound).Once()
+
+		_, err := client.GetEncounters(ctx, pokemon)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrPokemonNotFound)
+	})
+
+	t.Run("it should return an error without calling the backend if the pokemon has no encounters URL", func(t *testing.T) {
+		client, mocks := newPokemonApiForTests(t)
+
+		_, err := client.GetEncounters(ctx, &Pokemon{ID: 1, Name: "bulbasaur"})
+
+		require.Error(t, err)
+		assert.EqualError(t, err, `pokesdk: pokemon "bulbasaur" has no location area encounters URL`)
+		mocks.backend.AssertNotCalled(t, "Process", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
 type pokemonApiMocks struct {
 	backend *pokesdktest.MockBackend
 }
@@ -223,5 +366,5 @@ func newPokemonApiForTests(t *testing.T) (PokemonAPI, pokemonApiMocks) {
 		backend: &pokesdktest.MockBackend{},
 	}
 
-	return PokemonAPI{cfg: Config{baseURL: "http://example.com/", backend: mocks.backend}}, mocks
+	return newPokemonAPI(Config{baseURL: "http://example.com/", backend: mocks.backend}), mocks
 }
@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiPokemonColorPath = "/pokemon-color"
+)
+
+type PokemonColorAPI struct {
+	ResourceAPI[PokemonColor, PokemonColorRef]
+}
+
+func newPokemonColorAPI(cfg Config) PokemonColorAPI {
+	return PokemonColorAPI{newResourceAPI[PokemonColor, PokemonColorRef](cfg, apiPokemonColorPath)}
+}
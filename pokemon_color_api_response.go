@@ -0,0 +1,12 @@
+package pokesdk
+
+type PokemonColorRef = ResourceRef[PokemonColor]
+
+type PokemonColorList = ResourceList[PokemonColorRef]
+
+type PokemonColor struct {
+	ID             int            `json:"id"`
+	Name           string         `json:"name"`
+	Names          LocalizedNames `json:"names"`
+	PokemonSpecies []SpeciesRef   `json:"pokemon_species"`
+}
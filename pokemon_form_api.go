@@ -0,0 +1,29 @@
+package pokesdk
+
+import "context"
+
+const (
+	apiPokemonFormPath = "/pokemon-form"
+)
+
+type PokemonFormAPI struct {
+	ResourceAPI[PokemonForm, PokemonFormRef]
+}
+
+func newPokemonFormAPI(cfg Config) PokemonFormAPI {
+	return PokemonFormAPI{newResourceAPI[PokemonForm, PokemonFormRef](cfg, apiPokemonFormPath)}
+}
+
+// GetByPokemon retrieves every Pokemon Form of the given Pokemon, in the order they're listed on the Pokemon.
+func (p PokemonFormAPI) GetByPokemon(ctx context.Context, pokemon *Pokemon) ([]*PokemonForm, error) {
+	forms := make([]*PokemonForm, 0, len(pokemon.Forms))
+	for _, ref := range pokemon.Forms {
+		form, err := p.get(ctx, ref.URL)
+		if err != nil {
+			return nil, err
+		}
+		forms = append(forms, form)
+	}
+
+	return forms, nil
+}
@@ -0,0 +1,33 @@
+package pokesdk
+
+type PokemonFormRef = ResourceRef[PokemonForm]
+
+type PokemonFormList = ResourceList[PokemonFormRef]
+
+type PokemonForm struct {
+	ID           int                `json:"id"`
+	Name         string             `json:"name"`
+	Order        int                `json:"order"`
+	FormOrder    int                `json:"form_order"`
+	IsDefault    bool               `json:"is_default"`
+	IsBattleOnly bool               `json:"is_battle_only"`
+	IsMega       bool               `json:"is_mega"`
+	FormName     string             `json:"form_name"`
+	Pokemon      PokemonRef         `json:"pokemon"`
+	Types        []PokemonType      `json:"types"`
+	Sprites      PokemonFormSprites `json:"sprites"`
+	VersionGroup VersionGroupRef    `json:"version_group"`
+	Names        LocalizedNames     `json:"names"`
+	FormNames    LocalizedNames     `json:"form_names"`
+}
+
+type PokemonFormSprites struct {
+	BackDefault      *string `json:"back_default"`
+	BackFemale       *string `json:"back_female"`
+	BackShiny        *string `json:"back_shiny"`
+	BackShinyFemale  *string `json:"back_shiny_female"`
+	FrontDefault     *string `json:"front_default"`
+	FrontFemale      *string `json:"front_female"`
+	FrontShiny       *string `json:"front_shiny"`
+	FrontShinyFemale *string `json:"front_shiny_female"`
+}
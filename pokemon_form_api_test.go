@@ -0,0 +1,69 @@
+package pokesdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jameshalsall/pokesdk/internal/backend"
+	"github.com/jameshalsall/pokesdk/pokesdktest"
+)
+
+func TestPokemonFormAPI_GetByPokemon(t *testing.T) {
+	ctx := context.Background()
+
+	pokemon := &Pokemon{
+		Name: "unown",
+		Forms: []PokemonFormRef{
+			{Name: "unown-a", URL: "http://example.com/pokemon-form/201/"},
+			{Name: "unown-b", URL: "http://example.com/pokemon-form/10001/"},
+		},
+	}
+
+	t.Run("it should get every form of a pokemon", func(t *testing.T) {
+		client, mocks := newPokemonFormApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon-form/201/", map[string]string(nil), mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(3).(*PokemonForm) = PokemonForm{ID: 201, Name: "unown-a", FormName: "a", IsDefault: true}
+		}).Return(nil).Once()
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon-form/10001/", map[string]string(nil), mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(3).(*PokemonForm) = PokemonForm{ID: 10001, Name: "unown-b", FormName: "b"}
+		}).Return(nil).Once()
+
+		forms, err := client.GetByPokemon(ctx, pokemon)
+
+		require.NoError(t, err)
+		require.Len(t, forms, 2)
+		assert.Equal(t, "a", forms[0].FormName)
+		assert.True(t, forms[0].IsDefault)
+		assert.Equal(t, "b", forms[1].FormName)
+	})
+
+	t.Run("it should return an error if a form does not exist", func(t *testing.T) {
+		client, mocks := newPokemonFormApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon-form/201/", map[string]string(nil), mock.Anything).Return(backend.ErrResourceNotFound).Once()
+
+		_, err := client.GetByPokemon(ctx, pokemon)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrPokemonFormNotFound)
+	})
+}
+
+type pokemonFormApiMocks struct {
+	backend *pokesdktest.MockBackend
+}
+
+func newPokemonFormApiForTests(t *testing.T) (PokemonFormAPI, pokemonFormApiMocks) {
+	t.Helper()
+
+	mocks := pokemonFormApiMocks{
+		backend: &pokesdktest.MockBackend{},
+	}
+
+	return newPokemonFormAPI(Config{baseURL: "http://example.com/", backend: mocks.backend}), mocks
+}
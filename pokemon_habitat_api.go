@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiPokemonHabitatPath = "/pokemon-habitat"
+)
+
+type PokemonHabitatAPI struct {
+	ResourceAPI[PokemonHabitat, PokemonHabitatRef]
+}
+
+func newPokemonHabitatAPI(cfg Config) PokemonHabitatAPI {
+	return PokemonHabitatAPI{newResourceAPI[PokemonHabitat, PokemonHabitatRef](cfg, apiPokemonHabitatPath)}
+}
@@ -0,0 +1,12 @@
+package pokesdk
+
+type PokemonHabitatRef = ResourceRef[PokemonHabitat]
+
+type PokemonHabitatList = ResourceList[PokemonHabitatRef]
+
+type PokemonHabitat struct {
+	ID             int            `json:"id"`
+	Name           string         `json:"name"`
+	Names          LocalizedNames `json:"names"`
+	PokemonSpecies []SpeciesRef   `json:"pokemon_species"`
+}
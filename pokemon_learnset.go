@@ -0,0 +1,72 @@
+package pokesdk
+
+import (
+	"cmp"
+	"slices"
+)
+
+const (
+	MoveLearnMethodLevelUp = "level-up"
+	MoveLearnMethodMachine = "machine"
+	MoveLearnMethodEgg     = "egg"
+	MoveLearnMethodTutor   = "tutor"
+)
+
+// LearnsetEntry is a single move that a Pokemon learns in a version group.
+type LearnsetEntry struct {
+	Move   MoveRef
+	Method MoveLearnMethodRef
+	// Level is the level the move is learned at, it is 0 for moves that aren't learned by levelling up.
+	Level int
+	// Order is used to sort moves learned at the same level, when the PokeAPI provides it.
+	Order *int
+}
+
+// Learnset returns the moves the Pokemon learns in the given version group using the given learn method (e.g.
+// MoveLearnMethodLevelUp), sorted by the level they are learned at. An empty method matches every learn method.
+func (p *Pokemon) Learnset(versionGroup, method string) []LearnsetEntry {
+	var entries []LearnsetEntry
+	for _, move := range p.Moves {
+		for _, detail := range move.VersionGroupDetails {
+			if detail.VersionGroup.Name != versionGroup {
+				continue
+			}
+			if method != "" && detail.MoveLearnMethod.Name != method {
+				continue
+			}
+
+			entries = append(entries, LearnsetEntry{
+				Move:   move.Move,
+				Method: detail.MoveLearnMethod,
+				Level:  detail.LevelLearnedAt,
+				Order:  detail.Order,
+			})
+		}
+	}
+
+	slices.SortStableFunc(entries, func(a, b LearnsetEntry) int {
+		if c := cmp.Compare(a.Level, b.Level); c != 0 {
+			return c
+		}
+		if c := compareOrder(a.Order, b.Order); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Move.Name, b.Move.Name)
+	})
+
+	return entries
+}
+
+// compareOrder compares two optional orders, with a missing order sorting last.
+func compareOrder(a, b *int) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	default:
+		return cmp.Compare(*a, *b)
+	}
+}
@@ -0,0 +1,82 @@
+package pokesdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPokemon_Learnset(t *testing.T) {
+	versionGroupDetail := func(versionGroup, method string, level int, order *int) MoveVersionGroupDetail {
+		return MoveVersionGroupDetail{
+			LevelLearnedAt:  level,
+			MoveLearnMethod: MoveLearnMethodRef{Name: method},
+			Order:           order,
+			VersionGroup:    VersionGroupRef{Name: versionGroup},
+		}
+	}
+	first, second := 1, 2
+
+	pokemon := &Pokemon{
+		Moves: []PokemonMove{
+			{
+				Move: MoveRef{Name: "vine-whip"},
+				VersionGroupDetails: []MoveVersionGroupDetail{
+					versionGroupDetail("red-blue", MoveLearnMethodLevelUp, 13, nil),
+					versionGroupDetail("sword-shield", MoveLearnMethodLevelUp, 3, nil),
+				},
+			},
+			{
+				Move: MoveRef{Name: "tackle"},
+				VersionGroupDetails: []MoveVersionGroupDetail{
+					versionGroupDetail("sword-shield", MoveLearnMethodLevelUp, 1, &second),
+				},
+			},
+			{
+				Move: MoveRef{Name: "growl"},
+				VersionGroupDetails: []MoveVersionGroupDetail{
+					versionGroupDetail("sword-shield", MoveLearnMethodLevelUp, 1, &first),
+				},
+			},
+			{
+				Move: MoveRef{Name: "solar-beam"},
+				VersionGroupDetails: []MoveVersionGroupDetail{
+					versionGroupDetail("sword-shield", MoveLearnMethodMachine, 0, nil),
+					versionGroupDetail("sword-shield", MoveLearnMethodLevelUp, 36, nil),
+				},
+			},
+		},
+	}
+
+	moveNames := func(entries []LearnsetEntry) []string {
+		var names []string
+		for _, entry := range entries {
+			names = append(names, entry.Move.Name)
+		}
+		return names
+	}
+
+	t.Run("it returns moves for the version group and method sorted by level and order", func(t *testing.T) {
+		learnset := pokemon.Learnset("sword-shield", MoveLearnMethodLevelUp)
+
+		assert.Equal(t, []string{"growl", "tackle", "vine-whip", "solar-beam"}, moveNames(learnset))
+		assert.Equal(t, 36, learnset[3].Level)
+	})
+
+	t.Run("it filters by learn method", func(t *testing.T) {
+		learnset := pokemon.Learnset("sword-shield", MoveLearnMethodMachine)
+
+		assert.Equal(t, []string{"solar-beam"}, moveNames(learnset))
+		assert.Equal(t, MoveLearnMethodMachine, learnset[0].Method.Name)
+	})
+
+	t.Run("it returns every learn method when the method is empty", func(t *testing.T) {
+		learnset := pokemon.Learnset("sword-shield", "")
+
+		assert.Equal(t, []string{"solar-beam", "growl", "tackle", "vine-whip", "solar-beam"}, moveNames(learnset))
+	})
+
+	t.Run("it returns nothing for an unknown version group", func(t *testing.T) {
+		assert.Empty(t, pokemon.Learnset("gold-silver", MoveLearnMethodLevelUp))
+	})
+}
@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiPokemonShapePath = "/pokemon-shape"
+)
+
+type PokemonShapeAPI struct {
+	ResourceAPI[PokemonShape, PokemonShapeRef]
+}
+
+func newPokemonShapeAPI(cfg Config) PokemonShapeAPI {
+	return PokemonShapeAPI{newResourceAPI[PokemonShape, PokemonShapeRef](cfg, apiPokemonShapePath)}
+}
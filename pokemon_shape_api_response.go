@@ -0,0 +1,18 @@
+package pokesdk
+
+type PokemonShapeRef = ResourceRef[PokemonShape]
+
+type PokemonShapeList = ResourceList[PokemonShapeRef]
+
+type PokemonShape struct {
+	ID             int            `json:"id"`
+	Name           string         `json:"name"`
+	AwesomeNames   []AwesomeName  `json:"awesome_names"`
+	Names          LocalizedNames `json:"names"`
+	PokemonSpecies []SpeciesRef   `json:"pokemon_species"`
+}
+
+type AwesomeName struct {
+	AwesomeName string      `json:"awesome_name"`
+	Language    LanguageRef `json:"language"`
+}
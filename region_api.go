@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiRegionPath = "/region"
+)
+
+type RegionAPI struct {
+	ResourceAPI[Region, RegionRef]
+}
+
+func newRegionAPI(cfg Config) RegionAPI {
+	return RegionAPI{newResourceAPI[Region, RegionRef](cfg, apiRegionPath)}
+}
@@ -0,0 +1,15 @@
+package pokesdk
+
+type RegionRef = ResourceRef[Region]
+
+type RegionList = ResourceList[RegionRef]
+
+type Region struct {
+	ID             int               `json:"id"`
+	Name           string            `json:"name"`
+	Locations      []LocationRef     `json:"locations"`
+	Names          LocalizedNames    `json:"names"`
+	MainGeneration *GenerationRef    `json:"main_generation"`
+	Pokedexes      []PokedexRef      `json:"pokedexes"`
+	VersionGroups  []VersionGroupRef `json:"version_groups"`
+}
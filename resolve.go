@@ -0,0 +1,36 @@
+package pokesdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/jameshalsall/pokesdk/internal/urlutil"
+)
+
+// ErrInvalidRef is returned when a reference can't be resolved into the requested type.
+var ErrInvalidRef = errors.New("pokesdk: invalid reference")
+
+// Resolve retrieves the resource that the given reference points to, using the reference's URL to work out which
+// resource it is (e.g. /ability/ for an Ability). It accepts NamedAPIResource as well as any typed reference.
+// T must be the type of the referenced resource, otherwise an error wrapping ErrInvalidRef is returned, and the
+// not found error of the resource is returned if it doesn't exist. The options are the same as the getters of an API.
+func Resolve[T any, R Reference](ctx context.Context, client *Client, ref R, opts ...GetOption) (*T, error) {
+	url := ref.ref().URL
+	resource, ok := urlutil.ResourceFromURL(url)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q is not a resource URL", ErrInvalidRef, url)
+	}
+
+	path := "/" + resource
+	info, ok := resources[path]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported resource %q", ErrInvalidRef, resource)
+	}
+	if want := reflect.TypeFor[T](); info.typ != want {
+		return nil, fmt.Errorf("%w: %s refers to %s, not %s", ErrInvalidRef, url, info.typ.Name(), want.Name())
+	}
+
+	return newResourceAPI[T, R](client.cfg, path).get(ctx, url, opts...)
+}
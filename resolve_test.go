@@ -0,0 +1,108 @@
+package pokesdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jameshalsall/pokesdk/internal/backend"
+	"github.com/jameshalsall/pokesdk/pokesdktest"
+)
+
+func TestResolve(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("it should resolve a named API resource into its type", func(t *testing.T) {
+		client, mocks := newClientForResolveTests(t)
+		mocks.backend.HydrateWith([]byte(`{"id": 65, "name": "overgrow"}`))
+
+		mocks.backend.On("Process", ctx, "https://pokeapi.co/api/v2/ability/65/", map[string]string(nil), mock.Anything).Return(nil).Once()
+
+		ability, err := Resolve[Ability](ctx, client, NamedAPIResource{Name: "overgrow", URL: "https://pokeapi.co/api/v2/ability/65/"})
+
+		require.NoError(t, err)
+		assert.Equal(t, 65, ability.ID)
+		assert.Equal(t, "overgrow", ability.Name)
+	})
+
+	t.Run("it should resolve a typed reference", func(t *testing.T) {
+		client, mocks := newClientForResolveTests(t)
+		mocks.backend.HydrateWith([]byte(`{"id": 12, "name": "grass"}`))
+
+		mocks.backend.On("Process", ctx, "https://pokeapi.co/api/v2/type/12/", map[string]string(nil), mock.Anything).Return(nil).Once()
+
+		typ, err := Resolve[Type](ctx, client, TypeRef{Name: "grass", URL: "https://pokeapi.co/api/v2/type/12/"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "grass", typ.Name)
+	})
+
+	t.Run("it should return the not found error of the resource", func(t *testing.T) {
+		client, mocks := newClientForResolveTests(t)
+
+		mocks.backend.On("Process", ctx, "https://pokeapi.co/api/v2/pokemon-species/9999/", map[string]string(nil), mock.Anything).Return(backend.ErrResourceNotFound).Once()
+
+		_, err := Resolve[PokemonSpecies](ctx, client, SpeciesRef{URL: "https://pokeapi.co/api/v2/pokemon-species/9999/"})
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrSpeciesNotFound)
+	})
+
+	t.Run("it should return an error if getting the resource fails", func(t *testing.T) {
+		client, mocks := newClientForResolveTests(t)
+
+		mocks.backend.On("Process", ctx, "https://pokeapi.co/api/v2/move/1/", map[string]string(nil), mock.Anything).Return(assert.AnError).Once()
+
+		_, err := Resolve[Move](ctx, client, NamedAPIResource{URL: "https://pokeapi.co/api/v2/move/1/"})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "pokesdk: error getting move")
+	})
+
+	tests := map[string]struct {
+		ref     NamedAPIResource
+		wantErr string
+	}{
+		"a reference to a different type": {
+			ref:     NamedAPIResource{URL: "https://pokeapi.co/api/v2/type/12/"},
+			wantErr: "refers to Type, not Ability",
+		},
+		"an unsupported resource": {
+			ref:     NamedAPIResource{URL: "https://pokeapi.co/api/v2/unknown-thing/1/"},
+			wantErr: `unsupported resource "unknown-thing"`,
+		},
+		"an empty reference": {
+			ref:     NamedAPIResource{},
+			wantErr: "is not a resource URL",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run("it should return an error for "+name, func(t *testing.T) {
+			client, _ := newClientForResolveTests(t)
+
+			_, err := Resolve[Ability](ctx, client, tt.ref)
+
+			require.Error(t, err)
+			assert.ErrorIs(t, err, ErrInvalidRef)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+type resolveMocks struct {
+	backend *pokesdktest.MockBackend
+}
+
+func newClientForResolveTests(t *testing.T) (*Client, resolveMocks) {
+	t.Helper()
+
+	mocks := resolveMocks{
+		backend: &pokesdktest.MockBackend{},
+	}
+
+	return &Client{cfg: Config{baseURL: "http://example.com/", backend: mocks.backend}}, mocks
+}
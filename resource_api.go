@@ -0,0 +1,123 @@
+package pokesdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/jameshalsall/pokesdk/internal/backend"
+	"github.com/jameshalsall/pokesdk/internal/urlutil"
+)
+
+// Reference is the constraint satisfied by NamedAPIResource and every typed reference to a resource (e.g. PokemonRef).
+type Reference interface {
+	ref() NamedAPIResource
+}
+
+// ResourceAPI provides access to the endpoints of a single PokeAPI resource, where T is the type of the resource and
+// R is the type of the references to it that are returned when listing.
+// Every API on the Client is built on a ResourceAPI, and NewResourceAPI can be used for endpoints the SDK doesn't
+// cover yet.
+type ResourceAPI[T any, R Reference] struct {
+	cfg  Config
+	path string
+	info resourceInfo
+}
+
+// NewResourceAPI creates a ResourceAPI for the resource at the given path (e.g. "/pokemon-species"), configured with
+// the same options as NewClient. Getting a resource that doesn't exist returns the API's ErrNotFound, which wraps
+// ErrNotFound.
+func NewResourceAPI[T any](path string, opts ...Option) ResourceAPI[T, NamedAPIResource] {
+	return newResourceAPI[T, NamedAPIResource](NewConfig(opts...), path)
+}
+
+func newResourceAPI[T any, R Reference](cfg Config, path string) ResourceAPI[T, R] {
+	return ResourceAPI[T, R]{cfg: cfg, path: path, info: resourceInfoFor(path)}
+}
+
+// resourceInfoFor returns the info of the resource at the given path. Resources that the SDK doesn't know about are
+// named after their path.
+func resourceInfoFor(path string) resourceInfo {
+	if info, ok := resources[path]; ok {
+		return info
+	}
+
+	name := strings.ReplaceAll(strings.Trim(path, "/"), "-", " ")
+	return resourceInfo{singular: name, plural: name, notFound: fmt.Errorf("%s: %w", name, ErrNotFound)}
+}
+
+// ErrNotFound returns the error that's returned when getting a resource that doesn't exist, for use with errors.Is.
+// It's the same error for every call, and is e.g. ErrSpeciesNotFound for the resource at "/pokemon-species".
+func (r ResourceAPI[T, R]) ErrNotFound() error {
+	return r.info.notFound
+}
+
+// List returns a Paginator for listing all resources.
+// It accepts no context argument because it should be provided to the paginator's functions instead.
+func (r ResourceAPI[T, R]) List() *ListPaginator[R] {
+	paginator := NewPaginator[*ResourceList[R]](r.url(r.path), func(ctx context.Context, nextUrl string) (*ResourceList[R], error) {
+		response := &ResourceList[R]{}
+		err := r.cfg.backend.Process(ctx, nextUrl, nil, response)
+		if err != nil {
+			return nil, fmt.Errorf("pokesdk: error listing %s: %w", r.info.plural, err)
+		}
+
+		return response, nil
+	})
+
+	return &ListPaginator[R]{paginator}
+}
+
+// GetByName retrieves a specific resource by its name.
+func (r ResourceAPI[T, R]) GetByName(ctx context.Context, name string, opts ...GetOption) (*T, error) {
+	return r.get(ctx, r.url(r.path+"/"+name), opts...)
+}
+
+// GetByID retrieves a specific resource by its ID.
+func (r ResourceAPI[T, R]) GetByID(ctx context.Context, ID int, opts ...GetOption) (*T, error) {
+	return r.get(ctx, r.url(r.path+"/"+strconv.Itoa(ID)), opts...)
+}
+
+// GetByRef retrieves a specific resource by its reference.
+// The reference is returned in the response from List()
+func (r ResourceAPI[T, R]) GetByRef(ctx context.Context, ref R, opts ...GetOption) (*T, error) {
+	return r.get(ctx, ref.ref().URL, opts...)
+}
+
+func (r ResourceAPI[T, R]) get(ctx context.Context, url string, opts ...GetOption) (*T, error) {
+	tree, err := planExpand(reflect.TypeFor[T](), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	response := new(T)
+	if err := getResource(ctx, r.cfg, r.info, url, response); err != nil {
+		return nil, err
+	}
+
+	if err := expand(ctx, r.cfg, response, tree); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (r ResourceAPI[T, R]) url(path string) string {
+	return urlutil.BuildURL(r.cfg.baseURL, path)
+}
+
+// getResource decodes the resource at the given URL into out, mapping a missing resource to its not found error.
+func getResource(ctx context.Context, cfg Config, info resourceInfo, url string, out any) error {
+	err := cfg.backend.Process(ctx, url, nil, out)
+	if err != nil {
+		if errors.Is(err, backend.ErrResourceNotFound) {
+			return info.notFound
+		}
+		return fmt.Errorf("pokesdk: error getting %s: %w", info.singular, err)
+	}
+
+	return nil
+}
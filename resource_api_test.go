@@ -0,0 +1,324 @@
+package pokesdk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jameshalsall/pokesdk/internal/backend"
+	"github.com/jameshalsall/pokesdk/pokesdktest"
+)
+
+type testResource struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func TestNewResourceAPI(t *testing.T) {
+	t.Run("it names the resource after its path", func(t *testing.T) {
+		api := NewResourceAPI[testResource]("/some-endpoint", WithCustomBaseURL("http://example.com/"))
+
+		assert.Equal(t, "/some-endpoint", api.path)
+		assert.Equal(t, "some endpoint", api.info.singular)
+		assert.Equal(t, "http://example.com/", api.cfg.baseURL)
+		assert.ErrorIs(t, api.info.notFound, ErrNotFound)
+		assert.EqualError(t, api.info.notFound, "some endpoint: not found")
+	})
+
+	t.Run("it uses the not found error of a resource the SDK supports", func(t *testing.T) {
+		api := NewResourceAPI[testResource]("/pokemon-species")
+
+		assert.Equal(t, "pokemon species", api.info.singular)
+		assert.Equal(t, ErrSpeciesNotFound, api.info.notFound)
+	})
+}
+
+func TestResourceAPI_ErrNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("it should return the not found error of a custom endpoint", func(t *testing.T) {
+		mocks := resourceApiMocks{backend: &pokesdktest.MockBackend{}}
+		api := NewResourceAPI[testResource]("/some-endpoint", WithCustomBaseURL("http://example.com/"))
+		api.cfg.backend = mocks.backend
+		errThingNotFound := api.ErrNotFound()
+
+		mocks.backend.On("Process", ctx, "http://example.com/some-endpoint/thing", map[string]string(nil), mock.Anything).Return(backend.ErrResourceNotFound).Once()
+
+		_, err := api.GetByName(ctx, "thing")
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, errThingNotFound)
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.Same(t, errThingNotFound, api.ErrNotFound())
+	})
+
+	t.Run("it should not match the not found error of another custom endpoint", func(t *testing.T) {
+		api := NewResourceAPI[testResource]("/some-endpoint")
+		other := NewResourceAPI[testResource]("/other-endpoint")
+
+		assert.NotErrorIs(t, api.ErrNotFound(), other.ErrNotFound())
+	})
+
+	t.Run("it should return the not found error of a resource the SDK supports", func(t *testing.T) {
+		api := NewResourceAPI[testResource]("/pokemon-species")
+
+		assert.Equal(t, ErrSpeciesNotFound, api.ErrNotFound())
+	})
+}
+
+func TestResourceAPI_List(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("it returns a paginator for listing resources", func(t *testing.T) {
+		api, mocks := newResourceApiForTests(t)
+		mocks.backend.HydrateWith([]byte(`{"count": 1, "next": null, "previous": null, "results": [{"name": "thing", "url": "https://pokeapi.co/api/v2/some-endpoint/1/"}]}`))
+
+		mocks.backend.On("Process", ctx, "http://example.com/some-endpoint", map[string]string(nil), mock.Anything).Return(nil).Once()
+
+		firstPage := api.List().Next(ctx)
+
+		require.NotNil(t, firstPage)
+		require.NoError(t, firstPage.Error)
+		require.Len(t, firstPage.Data.Results, 1)
+		assert.Equal(t, NamedAPIResource{Name: "thing", URL: "https://pokeapi.co/api/v2/some-endpoint/1/"}, firstPage.Data.Results[0])
+	})
+
+	t.Run("it should return an error on the page if listing fails", func(t *testing.T) {
+		api, mocks := newResourceApiForTests(t)
+		mocks.backend.On("Process", ctx, "http://example.com/some-endpoint", map[string]string(nil), mock.Anything).Return(assert.AnError).Once()
+
+		firstPage := api.List().Next(ctx)
+
+		require.NotNil(t, firstPage)
+		require.Error(t, firstPage.Error)
+		assert.Contains(t, firstPage.Error.Error(), "pokesdk: error listing things")
+	})
+}
+
+func TestResourceAPI_Get(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		url string
+		get func(api ResourceAPI[testResource, NamedAPIResource]) (*testResource, error)
+	}{
+		"by name": {
+			url: "http://example.com/some-endpoint/thing",
+			get: func(api ResourceAPI[testResource, NamedAPIResource]) (*testResource, error) {
+				return api.GetByName(ctx, "thing")
+			},
+		},
+		"by ID": {
+			url: "http://example.com/some-endpoint/1",
+			get: func(api ResourceAPI[testResource, NamedAPIResource]) (*testResource, error) {
+				return api.GetByID(ctx, 1)
+			},
+		},
+		"by ref": {
+			url: "http://example.com/some-endpoint/1/",
+			get: func(api ResourceAPI[testResource, NamedAPIResource]) (*testResource, error) {
+				return api.GetByRef(ctx, NamedAPIResource{Name: "thing", URL: "http://example.com/some-endpoint/1/"})
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run("it should get a resource "+name, func(t *testing.T) {
+			api, mocks := newResourceApiForTests(t)
+			mocks.backend.HydrateWith([]byte(`{"id": 1, "name": "thing"}`))
+
+			mocks.backend.On("Process", ctx, tt.url, map[string]string(nil), mock.Anything).Return(nil).Once()
+
+			got, err := tt.get(api)
+
+			require.NoError(t, err)
+			assert.Equal(t, &testResource{ID: 1, Name: "thing"}, got)
+		})
+
+		t.Run("it should return an error if getting "+name+" fails", func(t *testing.T) {
+			api, mocks := newResourceApiForTests(t)
+			mocks.backend.On("Process", ctx, tt.url, map[string]string(nil), mock.Anything).Return(assert.AnError).Once()
+
+			_, err := tt.get(api)
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "pokesdk: error getting thing")
+		})
+
+		t.Run("it should return the not found error when getting "+name, func(t *testing.T) {
+			api, mocks := newResourceApiForTests(t)
+			mocks.backend.On("Process", ctx, tt.url, map[string]string(nil), mock.Anything).Return(backend.ErrResourceNotFound).Once()
+
+			_, err := tt.get(api)
+
+			require.Error(t, err)
+			assert.ErrorIs(t, err, ErrNotFound)
+			assert.EqualError(t, err, "thing: not found")
+		})
+	}
+}
+
+// TestClient_ResourceAPIs checks that every resource in the registry has an API on the client that gets it from the
+// right endpoint, decodes it into the right type and returns the resource's not found error.
+func TestClient_ResourceAPIs(t *testing.T) {
+	ctx := context.Background()
+
+	for path, info := range resources {
+		newAPI := func(t *testing.T) (reflect.Value, resourceApiMocks) {
+			t.Helper()
+
+			mocks := resourceApiMocks{backend: &pokesdktest.MockBackend{}}
+			api, ok := clientAPIFor(newClient(Config{baseURL: "http://example.com/", backend: mocks.backend}), path)
+			require.True(t, ok, "the client has no API for %s", path)
+
+			return api, mocks
+		}
+
+		url := "http://example.com" + path
+
+		t.Run(info.singular+" not found error", func(t *testing.T) {
+			api, _ := newAPI(t)
+
+			method := api.MethodByName("ErrNotFound")
+			require.True(t, method.IsValid(), "the API for %s has no ErrNotFound", path)
+			assert.Equal(t, info.notFound, method.Call(nil)[0].Interface())
+		})
+
+		t.Run(info.singular+" list", func(t *testing.T) {
+			api, mocks := newAPI(t)
+			mocks.backend.HydrateWith([]byte(`{"count": 1, "next": null, "previous": null, "results": [{"name": "thing", "url": "` + url + `/1/"}]}`))
+
+			mocks.backend.On("Process", ctx, url, map[string]string(nil), mock.Anything).Return(nil).Once()
+
+			page := api.MethodByName("List").Call(nil)[0].MethodByName("Next").Call([]reflect.Value{reflect.ValueOf(ctx)})[0].Elem()
+
+			require.Nil(t, page.FieldByName("Error").Interface())
+			results := page.FieldByName("Data").Elem().FieldByName("Results")
+			require.Equal(t, 1, results.Len())
+			assert.Equal(t, url+"/1/", results.Index(0).FieldByName("URL").String())
+			ref, ok := reflect.New(results.Type().Elem()).Interface().(expandable)
+			require.True(t, ok, "the list should return typed references")
+			assert.Equal(t, info.typ, ref.resourceType())
+		})
+
+		t.Run(info.singular+" list error", func(t *testing.T) {
+			api, mocks := newAPI(t)
+			mocks.backend.On("Process", ctx, url, map[string]string(nil), mock.Anything).Return(assert.AnError).Once()
+
+			page := api.MethodByName("List").Call(nil)[0].MethodByName("Next").Call([]reflect.Value{reflect.ValueOf(ctx)})[0].Elem()
+
+			err, _ := page.FieldByName("Error").Interface().(error)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "pokesdk: error listing "+info.plural)
+		})
+
+		gets := map[string]struct {
+			url  string
+			args func(method reflect.Value) []reflect.Value
+		}{
+			"by ID": {url: url + "/1", args: func(reflect.Value) []reflect.Value {
+				return []reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(1)}
+			}},
+			"by ref": {url: url + "/1/", args: func(method reflect.Value) []reflect.Value {
+				ref := reflect.New(method.Type().In(1)).Elem()
+				ref.FieldByName("URL").SetString(url + "/1/")
+				return []reflect.Value{reflect.ValueOf(ctx), ref}
+			}},
+			"by name": {url: url + "/thing", args: func(reflect.Value) []reflect.Value {
+				return []reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf("thing")}
+			}},
+		}
+		methods := map[string]string{"by ID": "GetByID", "by ref": "GetByRef", "by name": "GetByName"}
+
+		for name, get := range gets {
+			call := func(t *testing.T, api reflect.Value) (reflect.Value, error) {
+				t.Helper()
+
+				method := api.MethodByName(methods[name])
+				if !method.IsValid() {
+					t.Skipf("%s has no %s", path, methods[name])
+				}
+				out := method.Call(get.args(method))
+				err, _ := out[1].Interface().(error)
+				return out[0], err
+			}
+
+			t.Run(info.singular+" get "+name, func(t *testing.T) {
+				api, mocks := newAPI(t)
+				mocks.backend.HydrateWith([]byte(`{"id": 1, "name": "thing"}`))
+
+				mocks.backend.On("Process", ctx, get.url, map[string]string(nil), mock.Anything).Return(nil).Once()
+
+				resource, err := call(t, api)
+
+				require.NoError(t, err)
+				require.Equal(t, reflect.PointerTo(info.typ), resource.Type())
+				assert.Equal(t, int64(1), resource.Elem().FieldByName("ID").Int())
+			})
+
+			t.Run(info.singular+" get "+name+" error", func(t *testing.T) {
+				api, mocks := newAPI(t)
+				mocks.backend.On("Process", ctx, get.url, map[string]string(nil), mock.Anything).Return(assert.AnError).Once()
+
+				_, err := call(t, api)
+
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "pokesdk: error getting "+info.singular)
+			})
+
+			t.Run(info.singular+" get "+name+" not found", func(t *testing.T) {
+				api, mocks := newAPI(t)
+				mocks.backend.On("Process", ctx, get.url, map[string]string(nil), mock.Anything).Return(backend.ErrResourceNotFound).Once()
+
+				_, err := call(t, api)
+
+				require.Error(t, err)
+				assert.ErrorIs(t, err, info.notFound)
+			})
+		}
+	}
+}
+
+// clientAPIFor returns the API on the client for the resource at the given path.
+func clientAPIFor(client *Client, path string) (reflect.Value, bool) {
+	v := reflect.ValueOf(client).Elem()
+	for i := range v.NumField() {
+		api := v.Field(i)
+		if !v.Type().Field(i).IsExported() || api.Kind() != reflect.Struct {
+			continue
+		}
+
+		resources := api.FieldByName("ResourceAPI")
+		if !resources.IsValid() {
+			// unnamed resources hold their ResourceAPI rather than embedding it
+			resources = api.FieldByName("resources")
+		}
+		if resources.IsValid() && resources.FieldByName("path").String() == path {
+			return api, true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+type resourceApiMocks struct {
+	backend *pokesdktest.MockBackend
+}
+
+func newResourceApiForTests(t *testing.T) (ResourceAPI[testResource, NamedAPIResource], resourceApiMocks) {
+	t.Helper()
+
+	mocks := resourceApiMocks{
+		backend: &pokesdktest.MockBackend{},
+	}
+
+	return ResourceAPI[testResource, NamedAPIResource]{
+		cfg:  Config{baseURL: "http://example.com/", backend: mocks.backend},
+		path: "/some-endpoint",
+		info: resourceInfo{singular: "thing", plural: "things", notFound: fmt.Errorf("thing: %w", ErrNotFound)},
+	}, mocks
+}
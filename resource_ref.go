@@ -0,0 +1,52 @@
+package pokesdk
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/jameshalsall/pokesdk/internal/urlutil"
+)
+
+// ResourceRef is a reference to a resource of type T, such as the PokemonRef values returned when listing Pokemon or
+// the SpeciesRef of a Pokemon. Every typed reference in the SDK is a ResourceRef, so the compiler stops a reference
+// to one resource from being used to get another.
+type ResourceRef[T any] struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+
+	// expanded is the referenced resource, when it has been fetched using Expand
+	expanded *T
+}
+
+// ID returns the ID of the referenced resource, parsed from its URL. It returns 0 if the URL doesn't end in an ID.
+func (r ResourceRef[T]) ID() int {
+	id, _ := urlutil.IDFromURL(r.URL)
+	return id
+}
+
+// Expanded returns the referenced resource if it was fetched along with the resource holding the reference, using
+// the Expand option.
+func (r ResourceRef[T]) Expanded() (*T, bool) {
+	return r.expanded, r.expanded != nil
+}
+
+// Resolve retrieves the referenced resource using the given client. An expanded resource is returned without
+// fetching it again, unless options are given.
+func (r ResourceRef[T]) Resolve(ctx context.Context, client *Client, opts ...GetOption) (*T, error) {
+	if r.expanded != nil && len(opts) == 0 {
+		return r.expanded, nil
+	}
+	return Resolve[T](ctx, client, r, opts...)
+}
+
+func (r ResourceRef[T]) ref() NamedAPIResource {
+	return NamedAPIResource{Name: r.Name, URL: r.URL}
+}
+
+func (r ResourceRef[T]) resourceType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (r *ResourceRef[T]) expand(resource any) {
+	r.expanded = resource.(*T)
+}
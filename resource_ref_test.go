@@ -0,0 +1,59 @@
+package pokesdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jameshalsall/pokesdk/internal/backend"
+)
+
+func TestResourceRef_ID(t *testing.T) {
+	tests := map[string]struct {
+		ref  ResourceRef[Pokemon]
+		want int
+	}{
+		"a URL ending in an ID":        {ref: PokemonRef{Name: "pikachu", URL: "https://pokeapi.co/api/v2/pokemon/25/"}, want: 25},
+		"a URL not ending in an ID":    {ref: PokemonRef{Name: "pikachu", URL: "https://pokeapi.co/api/v2/pokemon/pikachu/"}, want: 0},
+		"a reference without a URL":    {ref: PokemonRef{Name: "pikachu"}, want: 0},
+		"a URL without trailing slash": {ref: PokemonRef{URL: "https://pokeapi.co/api/v2/pokemon/6"}, want: 6},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.ref.ID())
+		})
+	}
+}
+
+func TestResourceRef_Resolve(t *testing.T) {
+	ctx := context.Background()
+	ref := SpeciesRef{Name: "pikachu", URL: "https://pokeapi.co/api/v2/pokemon-species/25/"}
+
+	t.Run("it should resolve the referenced resource", func(t *testing.T) {
+		client, mocks := newClientForResolveTests(t)
+		mocks.backend.HydrateWith([]byte(`{"id": 25, "name": "pikachu"}`))
+
+		mocks.backend.On("Process", ctx, "https://pokeapi.co/api/v2/pokemon-species/25/", map[string]string(nil), mock.Anything).Return(nil).Once()
+
+		species, err := ref.Resolve(ctx, client)
+
+		require.NoError(t, err)
+		assert.Equal(t, 25, species.ID)
+		assert.Equal(t, "pikachu", species.Name)
+	})
+
+	t.Run("it should return the not found error of the resource", func(t *testing.T) {
+		client, mocks := newClientForResolveTests(t)
+
+		mocks.backend.On("Process", ctx, "https://pokeapi.co/api/v2/pokemon-species/25/", map[string]string(nil), mock.Anything).Return(backend.ErrResourceNotFound).Once()
+
+		_, err := ref.Resolve(ctx, client)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrSpeciesNotFound)
+	})
+}
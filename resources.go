@@ -0,0 +1,62 @@
+package pokesdk
+
+import "reflect"
+
+// resourceInfo describes one of the PokeAPI resources that the SDK supports.
+type resourceInfo struct {
+	typ      reflect.Type
+	singular string
+	plural   string
+	notFound error
+}
+
+// resources holds every resource supported by the SDK, keyed by its path.
+var resources = map[string]resourceInfo{
+	apiAbilityPath:                 {typ: reflect.TypeFor[Ability](), singular: "ability", plural: "abilities", notFound: ErrAbilityNotFound},
+	apiBerryPath:                   {typ: reflect.TypeFor[Berry](), singular: "berry", plural: "berries", notFound: ErrBerryNotFound},
+	apiBerryFirmnessPath:           {typ: reflect.TypeFor[BerryFirmness](), singular: "berry firmness", plural: "berry firmnesses", notFound: ErrBerryFirmnessNotFound},
+	apiBerryFlavorPath:             {typ: reflect.TypeFor[BerryFlavor](), singular: "berry flavor", plural: "berry flavors", notFound: ErrBerryFlavorNotFound},
+	apiCharacteristicPath:          {typ: reflect.TypeFor[Characteristic](), singular: "characteristic", plural: "characteristics", notFound: ErrCharacteristicNotFound},
+	apiContestEffectPath:           {typ: reflect.TypeFor[ContestEffect](), singular: "contest effect", plural: "contest effects", notFound: ErrContestEffectNotFound},
+	apiContestTypePath:             {typ: reflect.TypeFor[ContestType](), singular: "contest type", plural: "contest types", notFound: ErrContestTypeNotFound},
+	apiEggGroupPath:                {typ: reflect.TypeFor[EggGroup](), singular: "egg group", plural: "egg groups", notFound: ErrEggGroupNotFound},
+	apiEncounterConditionPath:      {typ: reflect.TypeFor[EncounterCondition](), singular: "encounter condition", plural: "encounter conditions", notFound: ErrEncounterConditionNotFound},
+	apiEncounterConditionValuePath: {typ: reflect.TypeFor[EncounterConditionValue](), singular: "encounter condition value", plural: "encounter condition values", notFound: ErrEncounterConditionValueNotFound},
+	apiEncounterMethodPath:         {typ: reflect.TypeFor[EncounterMethod](), singular: "encounter method", plural: "encounter methods", notFound: ErrEncounterMethodNotFound},
+	apiEvolutionChainPath:          {typ: reflect.TypeFor[EvolutionChain](), singular: "evolution chain", plural: "evolution chains", notFound: ErrEvolutionChainNotFound},
+	apiGenderPath:                  {typ: reflect.TypeFor[Gender](), singular: "gender", plural: "genders", notFound: ErrGenderNotFound},
+	apiGenerationPath:              {typ: reflect.TypeFor[Generation](), singular: "generation", plural: "generations", notFound: ErrGenerationNotFound},
+	apiGrowthRatePath:              {typ: reflect.TypeFor[GrowthRate](), singular: "growth rate", plural: "growth rates", notFound: ErrGrowthRateNotFound},
+	apiItemPath:                    {typ: reflect.TypeFor[Item](), singular: "item", plural: "items", notFound: ErrItemNotFound},
+	apiItemAttributePath:           {typ: reflect.TypeFor[ItemAttribute](), singular: "item attribute", plural: "item attributes", notFound: ErrItemAttributeNotFound},
+	apiItemCategoryPath:            {typ: reflect.TypeFor[ItemCategory](), singular: "item category", plural: "item categories", notFound: ErrItemCategoryNotFound},
+	apiItemFlingEffectPath:         {typ: reflect.TypeFor[ItemFlingEffect](), singular: "item fling effect", plural: "item fling effects", notFound: ErrItemFlingEffectNotFound},
+	apiItemPocketPath:              {typ: reflect.TypeFor[ItemPocket](), singular: "item pocket", plural: "item pockets", notFound: ErrItemPocketNotFound},
+	apiLanguagePath:                {typ: reflect.TypeFor[Language](), singular: "language", plural: "languages", notFound: ErrLanguageNotFound},
+	apiLocationPath:                {typ: reflect.TypeFor[Location](), singular: "location", plural: "locations", notFound: ErrLocationNotFound},
+	apiLocationAreaPath:            {typ: reflect.TypeFor[LocationArea](), singular: "location area", plural: "location areas", notFound: ErrLocationAreaNotFound},
+	apiMachinePath:                 {typ: reflect.TypeFor[Machine](), singular: "machine", plural: "machines", notFound: ErrMachineNotFound},
+	apiMoveAilmentPath:             {typ: reflect.TypeFor[MoveAilment](), singular: "move ailment", plural: "move ailments", notFound: ErrMoveAilmentNotFound},
+	apiMovePath:                    {typ: reflect.TypeFor[Move](), singular: "move", plural: "moves", notFound: ErrMoveNotFound},
+	apiMoveBattleStylePath:         {typ: reflect.TypeFor[MoveBattleStyle](), singular: "move battle style", plural: "move battle styles", notFound: ErrMoveBattleStyleNotFound},
+	apiMoveCategoryPath:            {typ: reflect.TypeFor[MoveCategory](), singular: "move category", plural: "move categories", notFound: ErrMoveCategoryNotFound},
+	apiMoveDamageClassPath:         {typ: reflect.TypeFor[MoveDamageClass](), singular: "move damage class", plural: "move damage classes", notFound: ErrMoveDamageClassNotFound},
+	apiMoveLearnMethodPath:         {typ: reflect.TypeFor[MoveLearnMethod](), singular: "move learn method", plural: "move learn methods", notFound: ErrMoveLearnMethodNotFound},
+	apiMoveTargetPath:              {typ: reflect.TypeFor[MoveTarget](), singular: "move target", plural: "move targets", notFound: ErrMoveTargetNotFound},
+	apiNaturePath:                  {typ: reflect.TypeFor[Nature](), singular: "nature", plural: "natures", notFound: ErrNatureNotFound},
+	apiPalParkAreaPath:             {typ: reflect.TypeFor[PalParkArea](), singular: "pal park area", plural: "pal park areas", notFound: ErrPalParkAreaNotFound},
+	apiPokeathlonStatPath:          {typ: reflect.TypeFor[PokeathlonStat](), singular: "pokeathlon stat", plural: "pokeathlon stats", notFound: ErrPokeathlonStatNotFound},
+	apiPokedexPath:                 {typ: reflect.TypeFor[Pokedex](), singular: "pokedex", plural: "pokedexes", notFound: ErrPokedexNotFound},
+	apiPokemonPath:                 {typ: reflect.TypeFor[Pokemon](), singular: "pokemon", plural: "pokemon", notFound: ErrPokemonNotFound},
+	apiPokemonColorPath:            {typ: reflect.TypeFor[PokemonColor](), singular: "pokemon color", plural: "pokemon colors", notFound: ErrPokemonColorNotFound},
+	apiPokemonFormPath:             {typ: reflect.TypeFor[PokemonForm](), singular: "pokemon form", plural: "pokemon forms", notFound: ErrPokemonFormNotFound},
+	apiPokemonHabitatPath:          {typ: reflect.TypeFor[PokemonHabitat](), singular: "pokemon habitat", plural: "pokemon habitats", notFound: ErrPokemonHabitatNotFound},
+	apiPokemonShapePath:            {typ: reflect.TypeFor[PokemonShape](), singular: "pokemon shape", plural: "pokemon shapes", notFound: ErrPokemonShapeNotFound},
+	apiRegionPath:                  {typ: reflect.TypeFor[Region](), singular: "region", plural: "regions", notFound: ErrRegionNotFound},
+	apiSpeciesPath:                 {typ: reflect.TypeFor[PokemonSpecies](), singular: "pokemon species", plural: "pokemon species", notFound: ErrSpeciesNotFound},
+	apiStatPath:                    {typ: reflect.TypeFor[Stat](), singular: "stat", plural: "stats", notFound: ErrStatNotFound},
+	apiSuperContestEffectPath:      {typ: reflect.TypeFor[SuperContestEffect](), singular: "super contest effect", plural: "super contest effects", notFound: ErrSuperContestEffectNotFound},
+	apiTypePath:                    {typ: reflect.TypeFor[Type](), singular: "type", plural: "types", notFound: ErrTypeNotFound},
+	apiVersionPath:                 {typ: reflect.TypeFor[Version](), singular: "version", plural: "versions", notFound: ErrVersionNotFound},
+	apiVersionGroupPath:            {typ: reflect.TypeFor[VersionGroup](), singular: "version group", plural: "version groups", notFound: ErrVersionGroupNotFound},
+}
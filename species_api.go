@@ -0,0 +1,20 @@
+package pokesdk
+
+import "context"
+
+const (
+	apiSpeciesPath = "/pokemon-species"
+)
+
+type SpeciesAPI struct {
+	ResourceAPI[PokemonSpecies, SpeciesRef]
+}
+
+func newSpeciesAPI(cfg Config) SpeciesAPI {
+	return SpeciesAPI{newResourceAPI[PokemonSpecies, SpeciesRef](cfg, apiSpeciesPath)}
+}
+
+// GetByPokemon retrieves the Pokemon Species that the given Pokemon belongs to.
+func (s SpeciesAPI) GetByPokemon(ctx context.Context, pokemon *Pokemon) (*PokemonSpecies, error) {
+	return s.get(ctx, pokemon.Species.URL)
+}
@@ -0,0 +1,66 @@
+package pokesdk
+
+type SpeciesRef = ResourceRef[PokemonSpecies]
+
+type SpeciesList = ResourceList[SpeciesRef]
+
+type PokemonSpecies struct {
+	ID                   int                      `json:"id"`
+	Name                 string                   `json:"name"`
+	Order                int                      `json:"order"`
+	GenderRate           int                      `json:"gender_rate"`
+	CaptureRate          int                      `json:"capture_rate"`
+	BaseHappiness        *int                     `json:"base_happiness"`
+	IsBaby               bool                     `json:"is_baby"`
+	IsLegendary          bool                     `json:"is_legendary"`
+	IsMythical           bool                     `json:"is_mythical"`
+	HatchCounter         *int                     `json:"hatch_counter"`
+	HasGenderDifferences bool                     `json:"has_gender_differences"`
+	FormsSwitchable      bool                     `json:"forms_switchable"`
+	GrowthRate           GrowthRateRef            `json:"growth_rate"`
+	PokedexNumbers       []PokemonSpeciesDexEntry `json:"pokedex_numbers"`
+	EggGroups            []EggGroupRef            `json:"egg_groups"`
+	Color                PokemonColorRef          `json:"color"`
+	Shape                *PokemonShapeRef         `json:"shape"`
+	EvolvesFromSpecies   *SpeciesRef              `json:"evolves_from_species"`
+	EvolutionChain       EvolutionChainRef        `json:"evolution_chain"`
+	Habitat              *PokemonHabitatRef       `json:"habitat"`
+	Generation           GenerationRef            `json:"generation"`
+	Names                LocalizedNames           `json:"names"`
+	PalParkEncounters    []PalParkEncounterArea   `json:"pal_park_encounters"`
+	FlavorTextEntries    []FlavorText             `json:"flavor_text_entries"`
+	FormDescriptions     []Description            `json:"form_descriptions"`
+	Genera               []Genus                  `json:"genera"`
+	Varieties            []PokemonSpeciesVariety  `json:"varieties"`
+}
+
+type PokemonSpeciesDexEntry struct {
+	EntryNumber int        `json:"entry_number"`
+	Pokedex     PokedexRef `json:"pokedex"`
+}
+
+type PalParkEncounterArea struct {
+	BaseScore int            `json:"base_score"`
+	Rate      int            `json:"rate"`
+	Area      PalParkAreaRef `json:"area"`
+}
+
+type Genus struct {
+	Genus    string      `json:"genus"`
+	Language LanguageRef `json:"language"`
+}
+
+type PokemonSpeciesVariety struct {
+	IsDefault bool       `json:"is_default"`
+	Pokemon   PokemonRef `json:"pokemon"`
+}
+
+// DefaultVariety returns the reference to the default Pokemon of the species, e.g. venusaur rather than venusaur-mega.
+func (s *PokemonSpecies) DefaultVariety() (PokemonRef, bool) {
+	for _, variety := range s.Varieties {
+		if variety.IsDefault {
+			return variety.Pokemon, true
+		}
+	}
+	return PokemonRef{}, false
+}
@@ -0,0 +1,80 @@
+package pokesdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jameshalsall/pokesdk/internal/backend"
+	"github.com/jameshalsall/pokesdk/pokesdktest"
+)
+
+func TestSpeciesAPI_GetByPokemon(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("it should get the species of a pokemon", func(t *testing.T) {
+		client, mocks := newSpeciesApiForTests(t)
+		mocks.backend.HydrateWith([]byte(`{"id": 1, "name": "bulbasaur", "evolution_chain": {"url": "https://pokeapi.co/api/v2/evolution-chain/1/"}}`))
+
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon-species/1/", map[string]string(nil), mock.Anything).Return(nil).Once()
+
+		pokemon := &Pokemon{ID: 1, Name: "bulbasaur", Species: SpeciesRef{Name: "bulbasaur", URL: "http://example.com/pokemon-species/1/"}}
+		species, err := client.GetByPokemon(ctx, pokemon)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, species.ID)
+		assert.Equal(t, "bulbasaur", species.Name)
+		assert.Equal(t, "https://pokeapi.co/api/v2/evolution-chain/1/", species.EvolutionChain.URL)
+	})
+
+	t.Run("it should return an error if the species does not exist", func(t *testing.T) {
+		client, mocks := newSpeciesApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon-species/99/", map[string]string(nil), mock.Anything).Return(backend.ErrResourceNotFound).Once()
+
+		pokemon := &Pokemon{Species: SpeciesRef{URL: "http://example.com/pokemon-species/99/"}}
+		_, err := client.GetByPokemon(ctx, pokemon)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrSpeciesNotFound)
+	})
+}
+
+func TestPokemonSpecies_DefaultVariety(t *testing.T) {
+	t.Run("it returns the default variety", func(t *testing.T) {
+		species := &PokemonSpecies{
+			Varieties: []PokemonSpeciesVariety{
+				{IsDefault: false, Pokemon: PokemonRef{Name: "raichu-alola"}},
+				{IsDefault: true, Pokemon: PokemonRef{Name: "raichu"}},
+			},
+		}
+
+		ref, ok := species.DefaultVariety()
+
+		assert.True(t, ok)
+		assert.Equal(t, "raichu", ref.Name)
+	})
+
+	t.Run("it returns false if there is no default variety", func(t *testing.T) {
+		_, ok := (&PokemonSpecies{}).DefaultVariety()
+
+		assert.False(t, ok)
+	})
+}
+
+type speciesApiMocks struct {
+	backend *pokesdktest.MockBackend
+}
+
+func newSpeciesApiForTests(t *testing.T) (SpeciesAPI, speciesApiMocks) {
+	t.Helper()
+
+	mocks := speciesApiMocks{
+		backend: &pokesdktest.MockBackend{},
+	}
+
+	return newSpeciesAPI(Config{baseURL: "http://example.com/", backend: mocks.backend}), mocks
+}
@@ -0,0 +1,76 @@
+package pokesdk
+
+import (
+	"context"
+	"slices"
+)
+
+// SpeciesFilter selects species by their color, shape and habitat. Empty fields are not filtered on.
+type SpeciesFilter struct {
+	Color   string
+	Shape   string
+	Habitat string
+}
+
+// Filter returns the species that match every criterion of the filter, ordered by species ID. The species are taken
+// from the color, shape and habitat resources, so at most one request is made per criterion.
+// It returns nil if the filter has no criteria.
+func (s SpeciesAPI) Filter(ctx context.Context, filter SpeciesFilter) ([]SpeciesRef, error) {
+	var sets [][]SpeciesRef
+	if filter.Color != "" {
+		color, err := newPokemonColorAPI(s.cfg).GetByName(ctx, filter.Color)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, color.PokemonSpecies)
+	}
+	if filter.Shape != "" {
+		shape, err := newPokemonShapeAPI(s.cfg).GetByName(ctx, filter.Shape)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, shape.PokemonSpecies)
+	}
+	if filter.Habitat != "" {
+		habitat, err := newPokemonHabitatAPI(s.cfg).GetByName(ctx, filter.Habitat)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, habitat.PokemonSpecies)
+	}
+
+	species := IntersectSpecies(sets...)
+	slices.SortStableFunc(species, func(a, b SpeciesRef) int {
+		return a.ID() - b.ID()
+	})
+	return species, nil
+}
+
+// IntersectSpecies returns the species that appear in every one of the given sets, in the order of the first set.
+// Species are matched by name.
+func IntersectSpecies(sets ...[]SpeciesRef) []SpeciesRef {
+	if len(sets) == 0 {
+		return nil
+	}
+
+	counts := make(map[string]int)
+	for _, set := range sets {
+		seen := make(map[string]bool, len(set))
+		for _, species := range set {
+			if !seen[species.Name] {
+				seen[species.Name] = true
+				counts[species.Name]++
+			}
+		}
+	}
+
+	var species []SpeciesRef
+	for _, ref := range sets[0] {
+		if counts[ref.Name] == len(sets) {
+			species = append(species, ref)
+			// only keep the first occurrence of a species
+			counts[ref.Name] = 0
+		}
+	}
+	return species
+}
@@ -0,0 +1,111 @@
+package pokesdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jameshalsall/pokesdk/internal/backend"
+)
+
+func TestSpeciesAPI_Filter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("it should return the species matching every criterion ordered by ID", func(t *testing.T) {
+		client, mocks := newSpeciesApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon-color/red", map[string]string(nil), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(3).(*PokemonColor) = PokemonColor{Name: "red", PokemonSpecies: []SpeciesRef{
+				{Name: "moltres", URL: "http://example.com/pokemon-species/146/"},
+				{Name: "charmander", URL: "http://example.com/pokemon-species/4/"},
+				{Name: "charizard", URL: "http://example.com/pokemon-species/6/"},
+			}}
+		}).Once()
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon-shape/wings", map[string]string(nil), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(3).(*PokemonShape) = PokemonShape{Name: "wings", PokemonSpecies: []SpeciesRef{
+				{Name: "moltres", URL: "http://example.com/pokemon-species/146/"},
+				{Name: "pidgey", URL: "http://example.com/pokemon-species/16/"},
+			}}
+		}).Once()
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon-habitat/rare", map[string]string(nil), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(3).(*PokemonHabitat) = PokemonHabitat{Name: "rare", PokemonSpecies: []SpeciesRef{
+				{Name: "mewtwo", URL: "http://example.com/pokemon-species/150/"},
+				{Name: "moltres", URL: "http://example.com/pokemon-species/146/"},
+			}}
+		}).Once()
+
+		species, err := client.Filter(ctx, SpeciesFilter{Color: "red", Shape: "wings", Habitat: "rare"})
+
+		require.NoError(t, err)
+		require.Len(t, species, 1)
+		assert.Equal(t, "moltres", species[0].Name)
+		mocks.backend.AssertExpectations(t)
+	})
+
+	t.Run("it should only fetch the criteria that are set", func(t *testing.T) {
+		client, mocks := newSpeciesApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon-color/red", map[string]string(nil), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(3).(*PokemonColor) = PokemonColor{Name: "red", PokemonSpecies: []SpeciesRef{
+				{Name: "moltres", URL: "http://example.com/pokemon-species/146/"},
+				{Name: "charmander", URL: "http://example.com/pokemon-species/4/"},
+			}}
+		}).Once()
+
+		species, err := client.Filter(ctx, SpeciesFilter{Color: "red"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []SpeciesRef{
+			{Name: "charmander", URL: "http://example.com/pokemon-species/4/"},
+			{Name: "moltres", URL: "http://example.com/pokemon-species/146/"},
+		}, species)
+		mocks.backend.AssertExpectations(t)
+	})
+
+	t.Run("it should return an error if a criterion does not exist", func(t *testing.T) {
+		client, mocks := newSpeciesApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/pokemon-shape/square", map[string]string(nil), mock.Anything).Return(backend.ErrResourceNotFound).Once()
+
+		_, err := client.Filter(ctx, SpeciesFilter{Shape: "square"})
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrPokemonShapeNotFound)
+	})
+
+	t.Run("it should return nothing for an empty filter", func(t *testing.T) {
+		client, _ := newSpeciesApiForTests(t)
+
+		species, err := client.Filter(ctx, SpeciesFilter{})
+
+		require.NoError(t, err)
+		assert.Nil(t, species)
+	})
+}
+
+func TestIntersectSpecies(t *testing.T) {
+	pikachu := SpeciesRef{Name: "pikachu"}
+	raichu := SpeciesRef{Name: "raichu"}
+	mareep := SpeciesRef{Name: "mareep"}
+
+	tests := map[string]struct {
+		sets [][]SpeciesRef
+		want []SpeciesRef
+	}{
+		"no sets":                     {sets: nil, want: nil},
+		"a single set":                {sets: [][]SpeciesRef{{pikachu, raichu}}, want: []SpeciesRef{pikachu, raichu}},
+		"overlapping sets":            {sets: [][]SpeciesRef{{raichu, pikachu, mareep}, {pikachu, raichu}}, want: []SpeciesRef{raichu, pikachu}},
+		"disjoint sets":               {sets: [][]SpeciesRef{{pikachu}, {mareep}}, want: nil},
+		"duplicates in a set":         {sets: [][]SpeciesRef{{pikachu, pikachu}, {pikachu}}, want: []SpeciesRef{pikachu}},
+		"species repeated in one set": {sets: [][]SpeciesRef{{pikachu}, {mareep, mareep}}, want: nil},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IntersectSpecies(tt.sets...))
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiStatPath = "/stat"
+)
+
+type StatAPI struct {
+	ResourceAPI[Stat, StatRef]
+}
+
+func newStatAPI(cfg Config) StatAPI {
+	return StatAPI{newResourceAPI[Stat, StatRef](cfg, apiStatPath)}
+}
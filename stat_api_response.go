@@ -0,0 +1,32 @@
+package pokesdk
+
+type StatRef = ResourceRef[Stat]
+
+type StatList = ResourceList[StatRef]
+
+type Stat struct {
+	ID               int                  `json:"id"`
+	Name             string               `json:"name"`
+	GameIndex        int                  `json:"game_index"`
+	IsBattleOnly     bool                 `json:"is_battle_only"`
+	AffectingMoves   MoveStatAffectSets   `json:"affecting_moves"`
+	AffectingNatures NatureStatAffectSets `json:"affecting_natures"`
+	Characteristics  []CharacteristicRef  `json:"characteristics"`
+	MoveDamageClass  *MoveDamageClassRef  `json:"move_damage_class"`
+	Names            LocalizedNames       `json:"names"`
+}
+
+type MoveStatAffectSets struct {
+	Increase []MoveStatAffect `json:"increase"`
+	Decrease []MoveStatAffect `json:"decrease"`
+}
+
+type MoveStatAffect struct {
+	Change int     `json:"change"`
+	Move   MoveRef `json:"move"`
+}
+
+type NatureStatAffectSets struct {
+	Increase []NatureRef `json:"increase"`
+	Decrease []NatureRef `json:"decrease"`
+}
@@ -0,0 +1,142 @@
+package pokesdk
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	StatHP             = "hp"
+	StatAttack         = "attack"
+	StatDefense        = "defense"
+	StatSpecialAttack  = "special-attack"
+	StatSpecialDefense = "special-defense"
+	StatSpeed          = "speed"
+
+	MinLevel   = 1
+	MaxLevel   = 100
+	MaxIV      = 31
+	MaxEV      = 252
+	MaxTotalEV = 510
+)
+
+var ErrInvalidStatInput = errors.New("pokesdk: invalid stat input")
+
+// mainStats are the stats that are used in battle calculations, in the order they're shown in game.
+var mainStats = []string{StatHP, StatAttack, StatDefense, StatSpecialAttack, StatSpecialDefense, StatSpeed}
+
+// StatSpread holds a value for each of the six main stats. It is used for IVs, EVs and calculated stats.
+type StatSpread struct {
+	HP             int
+	Attack         int
+	Defense        int
+	SpecialAttack  int
+	SpecialDefense int
+	Speed          int
+}
+
+// Get returns the value of the stat with the given PokeAPI name (e.g. StatSpecialAttack).
+func (s StatSpread) Get(stat string) int {
+	switch stat {
+	case StatHP:
+		return s.HP
+	case StatAttack:
+		return s.Attack
+	case StatDefense:
+		return s.Defense
+	case StatSpecialAttack:
+		return s.SpecialAttack
+	case StatSpecialDefense:
+		return s.SpecialDefense
+	case StatSpeed:
+		return s.Speed
+	default:
+		return 0
+	}
+}
+
+// Total returns the sum of all stats in the spread.
+func (s StatSpread) Total() int {
+	return s.HP + s.Attack + s.Defense + s.SpecialAttack + s.SpecialDefense + s.Speed
+}
+
+func (s *StatSpread) set(stat string, value int) {
+	switch stat {
+	case StatHP:
+		s.HP = value
+	case StatAttack:
+		s.Attack = value
+	case StatDefense:
+		s.Defense = value
+	case StatSpecialAttack:
+		s.SpecialAttack = value
+	case StatSpecialDefense:
+		s.SpecialDefense = value
+	case StatSpeed:
+		s.Speed = value
+	}
+}
+
+// CalculateStats calculates the actual stats of a Pokemon using the formulas from the main series games (generation
+// III onwards). The base stats are taken from Pokemon.Stats, and a nil nature is treated as neutral.
+func CalculateStats(base []PokemonStat, level int, ivs, evs StatSpread, nature *Nature) (StatSpread, error) {
+	if level < MinLevel || level > MaxLevel {
+		return StatSpread{}, fmt.Errorf("%w: level must be between %d and %d, got %d", ErrInvalidStatInput, MinLevel, MaxLevel, level)
+	}
+	if evs.Total() > MaxTotalEV {
+		return StatSpread{}, fmt.Errorf("%w: EVs must not total more than %d, got %d", ErrInvalidStatInput, MaxTotalEV, evs.Total())
+	}
+
+	baseStats := make(map[string]int, len(base))
+	for _, stat := range base {
+		baseStats[stat.Stat.Name] = stat.BaseStat
+	}
+
+	var stats StatSpread
+	for _, stat := range mainStats {
+		b, ok := baseStats[stat]
+		if !ok {
+			return StatSpread{}, fmt.Errorf("%w: missing base stat %q", ErrInvalidStatInput, stat)
+		}
+
+		iv, ev := ivs.Get(stat), evs.Get(stat)
+		if iv < 0 || iv > MaxIV {
+			return StatSpread{}, fmt.Errorf("%w: %s IV must be between 0 and %d, got %d", ErrInvalidStatInput, stat, MaxIV, iv)
+		}
+		if ev < 0 || ev > MaxEV {
+			return StatSpread{}, fmt.Errorf("%w: %s EV must be between 0 and %d, got %d", ErrInvalidStatInput, stat, MaxEV, ev)
+		}
+
+		value := (2*b + iv + ev/4) * level / 100
+		switch {
+		case stat == StatHP && b == 1:
+			// shedinja always has exactly 1 HP
+			value = 1
+		case stat == StatHP:
+			value += level + 10
+		default:
+			value = (value + 5) * natureModifier(nature, stat) / 100
+		}
+		stats.set(stat, value)
+	}
+
+	return stats, nil
+}
+
+// natureModifier returns the percentage that the nature modifies the given stat by.
+func natureModifier(nature *Nature, stat string) int {
+	if nature == nil || nature.IncreasedStat == nil || nature.DecreasedStat == nil {
+		return 100
+	}
+
+	switch {
+	case nature.IncreasedStat.Name == nature.DecreasedStat.Name:
+		return 100
+	case nature.IncreasedStat.Name == stat:
+		return 110
+	case nature.DecreasedStat.Name == stat:
+		return 90
+	default:
+		return 100
+	}
+}
@@ -0,0 +1,88 @@
+package pokesdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newBaseStatsForTests(hp, attack, defense, specialAttack, specialDefense, speed int) []PokemonStat {
+	return []PokemonStat{
+		{BaseStat: hp, Stat: StatRef{Name: StatHP}},
+		{BaseStat: attack, Stat: StatRef{Name: StatAttack}},
+		{BaseStat: defense, Stat: StatRef{Name: StatDefense}},
+		{BaseStat: specialAttack, Stat: StatRef{Name: StatSpecialAttack}},
+		{BaseStat: specialDefense, Stat: StatRef{Name: StatSpecialDefense}},
+		{BaseStat: speed, Stat: StatRef{Name: StatSpeed}},
+	}
+}
+
+func TestCalculateStats(t *testing.T) {
+	adamant := &Nature{Name: "adamant", IncreasedStat: &StatRef{Name: StatAttack}, DecreasedStat: &StatRef{Name: StatSpecialAttack}}
+
+	t.Run("it calculates stats using the main series formulas", func(t *testing.T) {
+		// the worked example for a level 78 garchomp from bulbapedia
+		garchomp := newBaseStatsForTests(108, 130, 95, 80, 85, 102)
+		ivs := StatSpread{HP: 24, Attack: 12, Defense: 30, SpecialAttack: 16, SpecialDefense: 23, Speed: 5}
+		evs := StatSpread{HP: 74, Attack: 190, Defense: 91, SpecialAttack: 48, SpecialDefense: 84, Speed: 23}
+
+		stats, err := CalculateStats(garchomp, 78, ivs, evs, adamant)
+
+		require.NoError(t, err)
+		assert.Equal(t, StatSpread{HP: 289, Attack: 278, Defense: 193, SpecialAttack: 135, SpecialDefense: 171, Speed: 171}, stats)
+	})
+
+	t.Run("it treats a nil nature as neutral", func(t *testing.T) {
+		stats, err := CalculateStats(newBaseStatsForTests(100, 100, 100, 100, 100, 100), 100, StatSpread{}, StatSpread{}, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, StatSpread{HP: 310, Attack: 205, Defense: 205, SpecialAttack: 205, SpecialDefense: 205, Speed: 205}, stats)
+	})
+
+	t.Run("it treats a nature that raises and lowers the same stat as neutral", func(t *testing.T) {
+		hardy := &Nature{Name: "hardy", IncreasedStat: &StatRef{Name: StatAttack}, DecreasedStat: &StatRef{Name: StatAttack}}
+
+		stats, err := CalculateStats(newBaseStatsForTests(100, 100, 100, 100, 100, 100), 100, StatSpread{}, StatSpread{}, hardy)
+
+		require.NoError(t, err)
+		assert.Equal(t, 205, stats.Attack)
+	})
+
+	t.Run("it always gives a pokemon with a base HP of 1 exactly 1 HP", func(t *testing.T) {
+		shedinja := newBaseStatsForTests(1, 90, 45, 30, 30, 40)
+
+		stats, err := CalculateStats(shedinja, 100, StatSpread{HP: MaxIV}, StatSpread{HP: MaxEV}, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, stats.HP)
+	})
+
+	t.Run("it returns an error for invalid input", func(t *testing.T) {
+		base := newBaseStatsForTests(100, 100, 100, 100, 100, 100)
+
+		tests := map[string]struct {
+			base  []PokemonStat
+			level int
+			ivs   StatSpread
+			evs   StatSpread
+		}{
+			"level too low":     {base: base, level: 0},
+			"level too high":    {base: base, level: 101},
+			"IV too high":       {base: base, level: 50, ivs: StatSpread{Speed: 32}},
+			"negative IV":       {base: base, level: 50, ivs: StatSpread{Speed: -1}},
+			"EV too high":       {base: base, level: 50, evs: StatSpread{Attack: 253}},
+			"EV total too high": {base: base, level: 50, evs: StatSpread{Attack: 252, Speed: 252, HP: 8}},
+			"missing base stat": {base: base[:5], level: 50},
+		}
+
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := CalculateStats(tt.base, tt.level, tt.ivs, tt.evs, nil)
+
+				require.Error(t, err)
+				assert.ErrorIs(t, err, ErrInvalidStatInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,50 @@
+package pokesdk
+
+import "context"
+
+const (
+	apiSuperContestEffectPath = "/super-contest-effect"
+)
+
+type SuperContestEffectAPI struct {
+	resources ResourceAPI[SuperContestEffect, SuperContestEffectRef]
+}
+
+func newSuperContestEffectAPI(cfg Config) SuperContestEffectAPI {
+	return SuperContestEffectAPI{resources: newResourceAPI[SuperContestEffect, SuperContestEffectRef](cfg, apiSuperContestEffectPath)}
+}
+
+// ErrNotFound returns the error that's returned when getting a super contest effect that doesn't exist, which is
+// ErrSuperContestEffectNotFound.
+func (s SuperContestEffectAPI) ErrNotFound() error {
+	return s.resources.ErrNotFound()
+}
+
+// List returns a Paginator for listing all Super Contest Effects.
+// It accepts no context argument because it should be provided to the paginator's functions instead.
+func (s SuperContestEffectAPI) List() *ListPaginator[SuperContestEffectRef] {
+	return s.resources.List()
+}
+
+// GetByID retrieves a specific Super Contest Effect by its ID.
+func (s SuperContestEffectAPI) GetByID(ctx context.Context, ID int, opts ...GetOption) (*SuperContestEffect, error) {
+	return s.resources.GetByID(ctx, ID, opts...)
+}
+
+// GetByRef retrieves a specific Super Contest Effect by its reference.
+// The reference is returned in the response from List()
+func (s SuperContestEffectAPI) GetByRef(ctx context.Context, ref SuperContestEffectRef, opts ...GetOption) (*SuperContestEffect, error) {
+	return s.resources.GetByRef(ctx, ref, opts...)
+}
+
+// GetMany retrieves the super contest effects with the given IDs in parallel. The results are in the same order as the
+// IDs, and a super contest effect that can't be fetched has its error set in its Result without affecting the others.
+func (s SuperContestEffectAPI) GetMany(ctx context.Context, IDs []int, opts ...GetOption) []Result[SuperContestEffect] {
+	return s.resources.getManyByID(ctx, IDs, opts)
+}
+
+// GetManyByRef retrieves the super contest effects with the given references in parallel, such as the results of a page
+// from List(). It works the same way as GetMany.
+func (s SuperContestEffectAPI) GetManyByRef(ctx context.Context, refs []SuperContestEffectRef, opts ...GetOption) []Result[SuperContestEffect] {
+	return s.resources.GetManyByRef(ctx, refs, opts...)
+}
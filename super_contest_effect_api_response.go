@@ -0,0 +1,14 @@
+package pokesdk
+
+// SuperContestEffectRef references a Super Contest Effect.
+// Super contest effects are unnamed, so only the URL is populated.
+type SuperContestEffectRef = ResourceRef[SuperContestEffect]
+
+type SuperContestEffectList = ResourceList[SuperContestEffectRef]
+
+type SuperContestEffect struct {
+	ID                int          `json:"id"`
+	Appeal            int          `json:"appeal"`
+	FlavorTextEntries []FlavorText `json:"flavor_text_entries"`
+	Moves             []MoveRef    `json:"moves"`
+}
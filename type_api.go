@@ -0,0 +1,29 @@
+package pokesdk
+
+import "context"
+
+const (
+	apiTypePath = "/type"
+)
+
+type TypeAPI struct {
+	ResourceAPI[Type, TypeRef]
+}
+
+func newTypeAPI(cfg Config) TypeAPI {
+	return TypeAPI{newResourceAPI[Type, TypeRef](cfg, apiTypePath)}
+}
+
+// GetChart fetches each of the StandardTypes and builds a TypeChart from their current damage relations.
+func (t TypeAPI) GetChart(ctx context.Context) (*TypeChart, error) {
+	types := make([]*Type, 0, len(StandardTypes))
+	for _, name := range StandardTypes {
+		typ, err := t.GetByName(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, typ)
+	}
+
+	return NewTypeChart(types), nil
+}
@@ -0,0 +1,38 @@
+package pokesdk
+
+type TypeRef = ResourceRef[Type]
+
+type TypeList = ResourceList[TypeRef]
+
+type Type struct {
+	ID                  int                   `json:"id"`
+	Name                string                `json:"name"`
+	DamageRelations     TypeRelations         `json:"damage_relations"`
+	PastDamageRelations []TypeRelationsPast   `json:"past_damage_relations"`
+	GameIndices         []GenerationGameIndex `json:"game_indices"`
+	Generation          GenerationRef         `json:"generation"`
+	MoveDamageClass     *MoveDamageClassRef   `json:"move_damage_class"`
+	Names               LocalizedNames        `json:"names"`
+	Pokemon             []TypePokemon         `json:"pokemon"`
+	Moves               []MoveRef             `json:"moves"`
+}
+
+type TypeRelations struct {
+	NoDamageTo       []TypeRef `json:"no_damage_to"`
+	HalfDamageTo     []TypeRef `json:"half_damage_to"`
+	DoubleDamageTo   []TypeRef `json:"double_damage_to"`
+	NoDamageFrom     []TypeRef `json:"no_damage_from"`
+	HalfDamageFrom   []TypeRef `json:"half_damage_from"`
+	DoubleDamageFrom []TypeRef `json:"double_damage_from"`
+}
+
+// TypeRelationsPast holds the damage relations a type had up to and including the given generation.
+type TypeRelationsPast struct {
+	Generation      GenerationRef `json:"generation"`
+	DamageRelations TypeRelations `json:"damage_relations"`
+}
+
+type TypePokemon struct {
+	Slot    int        `json:"slot"`
+	Pokemon PokemonRef `json:"pokemon"`
+}
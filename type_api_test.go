@@ -0,0 +1,21 @@
+package pokesdk
+
+import (
+	"testing"
+
+	"github.com/jameshalsall/pokesdk/pokesdktest"
+)
+
+type typeApiMocks struct {
+	backend *pokesdktest.MockBackend
+}
+
+func newTypeApiForTests(t *testing.T) (TypeAPI, typeApiMocks) {
+	t.Helper()
+
+	mocks := typeApiMocks{
+		backend: &pokesdktest.MockBackend{},
+	}
+
+	return newTypeAPI(Config{baseURL: "http://example.com/", backend: mocks.backend}), mocks
+}
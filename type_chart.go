@@ -0,0 +1,139 @@
+package pokesdk
+
+import "math"
+
+// StandardTypes are the 18 types used by the main series games, in the order they're usually shown in a type chart.
+var StandardTypes = []string{
+	"normal", "fire", "water", "electric", "grass", "ice", "fighting", "poison", "ground",
+	"flying", "psychic", "bug", "rock", "ghost", "dragon", "dark", "steel", "fairy",
+}
+
+// TypeChart holds the damage multipliers between a set of types.
+type TypeChart struct {
+	types       []string
+	multipliers map[string]map[string]float64
+}
+
+// NewTypeChart creates a TypeChart from the current damage relations of the given types.
+// The chart's types are kept in the order they are given.
+func NewTypeChart(types []*Type) *TypeChart {
+	chart := &TypeChart{multipliers: make(map[string]map[string]float64, len(types))}
+	for _, t := range types {
+		chart.add(t.Name, t.DamageRelations)
+	}
+	return chart
+}
+
+// NewTypeChartForGeneration creates a TypeChart using the damage relations that applied in the given generation
+// (e.g. 1 for generation I). Types that were introduced after the generation are left out of the chart.
+func NewTypeChartForGeneration(types []*Type, generation int) *TypeChart {
+	chart := &TypeChart{multipliers: make(map[string]map[string]float64, len(types))}
+	for _, t := range types {
+		if t.Generation.ID() > generation {
+			continue
+		}
+		chart.add(t.Name, t.damageRelationsInGeneration(generation))
+	}
+
+	// relations can refer to types that didn't exist yet in the generation, so drop them
+	for _, defending := range chart.multipliers {
+		for name := range defending {
+			if _, ok := chart.multipliers[name]; !ok {
+				delete(defending, name)
+			}
+		}
+	}
+
+	return chart
+}
+
+// Types returns the names of the types in the chart, in the order used by Matrix.
+func (c *TypeChart) Types() []string {
+	return append([]string(nil), c.types...)
+}
+
+// Multiplier returns the damage multiplier of an attacking type against one or more defending types.
+// Pairs of types that are missing from the chart are treated as neutral.
+func (c *TypeChart) Multiplier(attacking string, defending ...string) float64 {
+	multiplier := 1.0
+	for _, d := range defending {
+		if m, ok := c.multipliers[attacking][d]; ok {
+			multiplier *= m
+		}
+	}
+	return multiplier
+}
+
+// AgainstTypes returns the damage multiplier of an attacking type against a defender with the given types, such as
+// Pokemon.Types or the result of Pokemon.TypesInGeneration.
+func (c *TypeChart) AgainstTypes(attacking string, types []PokemonType) float64 {
+	defending := make([]string, 0, len(types))
+	for _, t := range types {
+		defending = append(defending, t.Type.Name)
+	}
+	return c.Multiplier(attacking, defending...)
+}
+
+// Matrix returns the full chart of multipliers, where the rows are attacking types and the columns are defending
+// types, both in the order returned by Types.
+func (c *TypeChart) Matrix() [][]float64 {
+	matrix := make([][]float64, len(c.types))
+	for i, attacking := range c.types {
+		matrix[i] = make([]float64, len(c.types))
+		for j, defending := range c.types {
+			matrix[i][j] = c.Multiplier(attacking, defending)
+		}
+	}
+	return matrix
+}
+
+func (c *TypeChart) add(name string, relations TypeRelations) {
+	multipliers := make(map[string]float64)
+	for _, t := range relations.DoubleDamageTo {
+		multipliers[t.Name] = 2
+	}
+	for _, t := range relations.HalfDamageTo {
+		multipliers[t.Name] = 0.5
+	}
+	for _, t := range relations.NoDamageTo {
+		multipliers[t.Name] = 0
+	}
+
+	c.types = append(c.types, name)
+	c.multipliers[name] = multipliers
+}
+
+// damageRelationsInGeneration returns the damage relations that applied to the type in the given generation.
+// Past relations apply up to and including their generation, so the earliest one that covers the generation wins.
+func (t *Type) damageRelationsInGeneration(generation int) TypeRelations {
+	relations := t.DamageRelations
+	closest := math.MaxInt
+	for _, past := range t.PastDamageRelations {
+		id := past.Generation.ID()
+		if id == 0 || id < generation || id >= closest {
+			continue
+		}
+		relations, closest = past.DamageRelations, id
+	}
+	return relations
+}
+
+// TypesInGeneration returns the types the Pokemon had in the given generation (e.g. 1 for generation I), taking
+// Pokemon.PastTypes into account. It returns Pokemon.Types if the Pokemon's types haven't changed since.
+func (p *Pokemon) TypesInGeneration(generation int) []PokemonType {
+	types := p.Types
+	closest := math.MaxInt
+	for _, past := range p.PastTypes {
+		id := past.Generation.ID()
+		if id == 0 || id < generation || id >= closest {
+			continue
+		}
+
+		types = make([]PokemonType, 0, len(past.Types))
+		for _, t := range past.Types {
+			types = append(types, PokemonType(t))
+		}
+		closest = id
+	}
+	return types
+}
@@ -0,0 +1,180 @@
+package pokesdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTypesForTests(t *testing.T) []*Type {
+	t.Helper()
+
+	refs := func(names ...string) []TypeRef {
+		var r []TypeRef
+		for _, name := range names {
+			r = append(r, TypeRef{Name: name})
+		}
+		return r
+	}
+	generation := func(id string) GenerationRef {
+		return GenerationRef{URL: "https://pokeapi.co/api/v2/generation/" + id + "/"}
+	}
+
+	return []*Type{
+		{
+			Name:            "normal",
+			Generation:      generation("1"),
+			DamageRelations: TypeRelations{HalfDamageTo: refs("steel"), NoDamageTo: refs("ghost")},
+		},
+		{
+			Name:            "ghost",
+			Generation:      generation("1"),
+			DamageRelations: TypeRelations{DoubleDamageTo: refs("ghost", "psychic"), NoDamageTo: refs("normal"), HalfDamageTo: refs("dark")},
+			PastDamageRelations: []TypeRelationsPast{
+				{
+					// in generation I ghost moves had no effect on psychic types
+					Generation:      generation("1"),
+					DamageRelations: TypeRelations{DoubleDamageTo: refs("ghost"), NoDamageTo: refs("normal", "psychic")},
+				},
+			},
+		},
+		{
+			Name:            "psychic",
+			Generation:      generation("1"),
+			DamageRelations: TypeRelations{DoubleDamageTo: refs("fighting", "poison"), HalfDamageTo: refs("psychic", "steel"), NoDamageTo: refs("dark")},
+		},
+		{
+			Name:            "dark",
+			Generation:      generation("2"),
+			DamageRelations: TypeRelations{DoubleDamageTo: refs("ghost", "psychic"), HalfDamageTo: refs("dark", "fighting", "fairy")},
+		},
+	}
+}
+
+func TestTypeChart_Multiplier(t *testing.T) {
+	chart := NewTypeChart(newTypesForTests(t))
+
+	t.Run("it returns the multiplier against a single type", func(t *testing.T) {
+		assert.Equal(t, 2.0, chart.Multiplier("ghost", "psychic"))
+		assert.Equal(t, 0.5, chart.Multiplier("psychic", "psychic"))
+		assert.Equal(t, 0.0, chart.Multiplier("normal", "ghost"))
+		assert.Equal(t, 1.0, chart.Multiplier("normal", "psychic"))
+	})
+
+	t.Run("it multiplies the multipliers against dual types", func(t *testing.T) {
+		assert.Equal(t, 4.0, chart.Multiplier("dark", "ghost", "psychic"))
+		assert.Equal(t, 0.0, chart.Multiplier("psychic", "psychic", "dark"))
+	})
+
+	t.Run("it treats unknown types as neutral", func(t *testing.T) {
+		assert.Equal(t, 1.0, chart.Multiplier("fire", "grass"))
+	})
+}
+
+func TestTypeChart_AgainstTypes(t *testing.T) {
+	chart := NewTypeChart(newTypesForTests(t))
+
+	gengar := []PokemonType{{Slot: 1, Type: TypeRef{Name: "ghost"}}, {Slot: 2, Type: TypeRef{Name: "poison"}}}
+
+	assert.Equal(t, 0.0, chart.AgainstTypes("normal", gengar))
+	assert.Equal(t, 2.0, chart.AgainstTypes("psychic", gengar))
+}
+
+func TestTypeChart_Matrix(t *testing.T) {
+	chart := NewTypeChart(newTypesForTests(t))
+
+	assert.Equal(t, []string{"normal", "ghost", "psychic", "dark"}, chart.Types())
+	assert.Equal(t, [][]float64{
+		{1, 0, 1, 1},
+		{0, 2, 2, 0.5},
+		{1, 1, 0.5, 0},
+		{1, 2, 2, 0.5},
+	}, chart.Matrix())
+}
+
+func TestNewTypeChartForGeneration(t *testing.T) {
+	t.Run("it uses past damage relations", func(t *testing.T) {
+		chart := NewTypeChartForGeneration(newTypesForTests(t), 1)
+
+		assert.Equal(t, 0.0, chart.Multiplier("ghost", "psychic"))
+	})
+
+	t.Run("it leaves out types introduced after the generation", func(t *testing.T) {
+		chart := NewTypeChartForGeneration(newTypesForTests(t), 1)
+
+		assert.Equal(t, []string{"normal", "ghost", "psychic"}, chart.Types())
+		assert.Equal(t, 1.0, chart.Multiplier("psychic", "dark"))
+	})
+
+	t.Run("it uses current damage relations once past relations no longer apply", func(t *testing.T) {
+		chart := NewTypeChartForGeneration(newTypesForTests(t), 2)
+
+		assert.Equal(t, 2.0, chart.Multiplier("ghost", "psychic"))
+		assert.Equal(t, 0.0, chart.Multiplier("psychic", "dark"))
+	})
+}
+
+func TestPokemon_TypesInGeneration(t *testing.T) {
+	// clefairy was a normal type before fairy was introduced in generation VI
+	pokemon := &Pokemon{
+		Types: []PokemonType{{Slot: 1, Type: TypeRef{Name: "fairy"}}},
+		PastTypes: []PastTypes{
+			{
+				Generation: GenerationRef{Name: "generation-v", URL: "https://pokeapi.co/api/v2/generation/5/"},
+				Types:      []Types{{Slot: 1, Type: TypeRef{Name: "normal"}}},
+			},
+		},
+	}
+
+	t.Run("it returns past types for an earlier generation", func(t *testing.T) {
+		types := pokemon.TypesInGeneration(3)
+
+		require.Len(t, types, 1)
+		assert.Equal(t, "normal", types[0].Type.Name)
+	})
+
+	t.Run("it returns past types for the last generation they applied to", func(t *testing.T) {
+		types := pokemon.TypesInGeneration(5)
+
+		require.Len(t, types, 1)
+		assert.Equal(t, "normal", types[0].Type.Name)
+	})
+
+	t.Run("it returns current types for later generations", func(t *testing.T) {
+		types := pokemon.TypesInGeneration(6)
+
+		require.Len(t, types, 1)
+		assert.Equal(t, "fairy", types[0].Type.Name)
+	})
+}
+
+func TestTypeAPI_GetChart(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("it fetches every standard type", func(t *testing.T) {
+		client, mocks := newTypeApiForTests(t)
+		mocks.backend.HydrateWith([]byte(`{"id": 1, "name": "normal", "damage_relations": {"no_damage_to": [{"name": "ghost"}]}}`))
+
+		mocks.backend.On("Process", ctx, mock.Anything, map[string]string(nil), mock.Anything).Return(nil).Times(len(StandardTypes))
+
+		chart, err := client.GetChart(ctx)
+
+		require.NoError(t, err)
+		assert.Len(t, chart.Types(), len(StandardTypes))
+		mocks.backend.AssertExpectations(t)
+	})
+
+	t.Run("it returns an error if a type cannot be fetched", func(t *testing.T) {
+		client, mocks := newTypeApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/type/normal", map[string]string(nil), mock.Anything).Return(assert.AnError).Once()
+
+		_, err := client.GetChart(ctx)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
@@ -0,0 +1,55 @@
+package pokesdk
+
+import (
+	"context"
+	"strconv"
+)
+
+const (
+	apiVersionPath = "/version"
+)
+
+type VersionAPI struct {
+	ResourceAPI[Version, VersionRef]
+
+	generations *versionGenerationCache
+}
+
+func newVersionAPI(cfg Config) VersionAPI {
+	return VersionAPI{
+		ResourceAPI: newResourceAPI[Version, VersionRef](cfg, apiVersionPath),
+		generations: newVersionGenerationCache(),
+	}
+}
+
+// GetGeneration returns the generation that the version with the given name or ID belongs to.
+// Results are cached, and every version in the same version group is cached at once, so only the first lookup for a
+// version group makes requests to the PokeAPI.
+func (v VersionAPI) GetGeneration(ctx context.Context, version string) (GenerationRef, error) {
+	if generation, ok := v.generations.get(version); ok {
+		return generation, nil
+	}
+
+	ver, err := v.GetByName(ctx, version)
+	if err != nil {
+		return GenerationRef{}, err
+	}
+
+	versionGroup, err := newVersionGroupAPI(v.cfg).GetByRef(ctx, ver.VersionGroup)
+	if err != nil {
+		return GenerationRef{}, err
+	}
+
+	// cache under the key that was looked up as well as the names and IDs of the versions, so that lookups by ID are
+	// cached too
+	versions := []string{version, ver.Name, strconv.Itoa(ver.ID)}
+	for _, ref := range versionGroup.Versions {
+		versions = append(versions, ref.Name)
+		if id := ref.ID(); id != 0 {
+			versions = append(versions, strconv.Itoa(id))
+		}
+	}
+	v.generations.set(versionGroup.Generation, versions...)
+
+	return versionGroup.Generation, nil
+}
@@ -0,0 +1,12 @@
+package pokesdk
+
+type VersionRef = ResourceRef[Version]
+
+type VersionList = ResourceList[VersionRef]
+
+type Version struct {
+	ID           int             `json:"id"`
+	Name         string          `json:"name"`
+	Names        LocalizedNames  `json:"names"`
+	VersionGroup VersionGroupRef `json:"version_group"`
+}
@@ -0,0 +1,116 @@
+package pokesdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jameshalsall/pokesdk/internal/backend"
+	"github.com/jameshalsall/pokesdk/pokesdktest"
+)
+
+func TestVersionAPI_GetGeneration(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("it should get the generation of a version and cache it", func(t *testing.T) {
+		client, mocks := newVersionApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/version/red", map[string]string(nil), mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(3).(*Version) = Version{ID: 1, Name: "red", VersionGroup: VersionGroupRef{Name: "red-blue", URL: "http://example.com/version-group/1/"}}
+		}).Return(nil).Once()
+		mocks.backend.On("Process", ctx, "http://example.com/version-group/1/", map[string]string(nil), mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(3).(*VersionGroup) = VersionGroup{
+				ID:         1,
+				Name:       "red-blue",
+				Generation: GenerationRef{Name: "generation-i", URL: "http://example.com/generation/1/"},
+				Versions:   []VersionRef{{Name: "red"}, {Name: "blue"}},
+			}
+		}).Return(nil).Once()
+
+		generation, err := client.GetGeneration(ctx, "red")
+		require.NoError(t, err)
+		assert.Equal(t, "generation-i", generation.Name)
+
+		// both versions in the group are now cached so no further requests are made
+		generation, err = client.GetGeneration(ctx, "red")
+		require.NoError(t, err)
+		assert.Equal(t, "generation-i", generation.Name)
+
+		generation, err = client.GetGeneration(ctx, "blue")
+		require.NoError(t, err)
+		assert.Equal(t, "generation-i", generation.Name)
+
+		mocks.backend.AssertExpectations(t)
+	})
+
+	t.Run("it should cache lookups by ID", func(t *testing.T) {
+		client, mocks := newVersionApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/version/1", map[string]string(nil), mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(3).(*Version) = Version{ID: 1, Name: "red", VersionGroup: VersionGroupRef{Name: "red-blue", URL: "http://example.com/version-group/1/"}}
+		}).Return(nil).Once()
+		mocks.backend.On("Process", ctx, "http://example.com/version-group/1/", map[string]string(nil), mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(3).(*VersionGroup) = VersionGroup{
+				ID:         1,
+				Name:       "red-blue",
+				Generation: GenerationRef{Name: "generation-i", URL: "http://example.com/generation/1/"},
+				Versions: []VersionRef{
+					{Name: "red", URL: "http://example.com/version/1/"},
+					{Name: "blue", URL: "http://example.com/version/2/"},
+				},
+			}
+		}).Return(nil).Once()
+
+		for _, version := range []string{"1", "1", "red", "2", "blue"} {
+			generation, err := client.GetGeneration(ctx, version)
+			require.NoError(t, err)
+			assert.Equal(t, "generation-i", generation.Name)
+		}
+
+		mocks.backend.AssertExpectations(t)
+	})
+
+	t.Run("it should not cache errors", func(t *testing.T) {
+		client, mocks := newVersionApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/version/nonexistent", map[string]string(nil), mock.Anything).Return(backend.ErrResourceNotFound).Twice()
+
+		_, err := client.GetGeneration(ctx, "nonexistent")
+		assert.ErrorIs(t, err, ErrVersionNotFound)
+
+		_, err = client.GetGeneration(ctx, "nonexistent")
+		assert.ErrorIs(t, err, ErrVersionNotFound)
+
+		mocks.backend.AssertExpectations(t)
+	})
+
+	t.Run("it should return an error if the version group does not exist", func(t *testing.T) {
+		client, mocks := newVersionApiForTests(t)
+
+		mocks.backend.On("Process", ctx, "http://example.com/version/red", map[string]string(nil), mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(3).(*Version) = Version{ID: 1, Name: "red", VersionGroup: VersionGroupRef{Name: "red-blue", URL: "http://example.com/version-group/1/"}}
+		}).Return(nil).Once()
+		mocks.backend.On("Process", ctx, "http://example.com/version-group/1/", map[string]string(nil), mock.Anything).Return(backend.ErrResourceNotFound).Once()
+
+		_, err := client.GetGeneration(ctx, "red")
+
+		assert.ErrorIs(t, err, ErrVersionGroupNotFound)
+	})
+}
+
+type versionApiMocks struct {
+	backend *pokesdktest.MockBackend
+}
+
+func newVersionApiForTests(t *testing.T) (VersionAPI, versionApiMocks) {
+	t.Helper()
+
+	mocks := versionApiMocks{
+		backend: &pokesdktest.MockBackend{},
+	}
+
+	return newVersionAPI(Config{baseURL: "http://example.com/", backend: mocks.backend}), mocks
+}
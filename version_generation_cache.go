@@ -0,0 +1,40 @@
+package pokesdk
+
+import "sync"
+
+// versionGenerationCache maps version names and IDs to the generation they belong to, so that repeated lookups don't need to
+// hit the PokeAPI. A nil cache is valid and caches nothing.
+type versionGenerationCache struct {
+	sync.RWMutex
+
+	generations map[string]GenerationRef
+}
+
+func newVersionGenerationCache() *versionGenerationCache {
+	return &versionGenerationCache{generations: make(map[string]GenerationRef)}
+}
+
+func (c *versionGenerationCache) get(version string) (GenerationRef, bool) {
+	if c == nil {
+		return GenerationRef{}, false
+	}
+
+	c.RLock()
+	defer c.RUnlock()
+
+	generation, ok := c.generations[version]
+	return generation, ok
+}
+
+func (c *versionGenerationCache) set(generation GenerationRef, versions ...string) {
+	if c == nil {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	for _, version := range versions {
+		c.generations[version] = generation
+	}
+}
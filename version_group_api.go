@@ -0,0 +1,13 @@
+package pokesdk
+
+const (
+	apiVersionGroupPath = "/version-group"
+)
+
+type VersionGroupAPI struct {
+	ResourceAPI[VersionGroup, VersionGroupRef]
+}
+
+func newVersionGroupAPI(cfg Config) VersionGroupAPI {
+	return VersionGroupAPI{newResourceAPI[VersionGroup, VersionGroupRef](cfg, apiVersionGroupPath)}
+}
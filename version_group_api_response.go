@@ -0,0 +1,16 @@
+package pokesdk
+
+type VersionGroupRef = ResourceRef[VersionGroup]
+
+type VersionGroupList = ResourceList[VersionGroupRef]
+
+type VersionGroup struct {
+	ID               int                  `json:"id"`
+	Name             string               `json:"name"`
+	Order            int                  `json:"order"`
+	Generation       GenerationRef        `json:"generation"`
+	MoveLearnMethods []MoveLearnMethodRef `json:"move_learn_methods"`
+	Pokedexes        []PokedexRef         `json:"pokedexes"`
+	Regions          []RegionRef          `json:"regions"`
+	Versions         []VersionRef         `json:"versions"`
+}